@@ -0,0 +1,89 @@
+package analysis
+
+import (
+	"math"
+)
+
+// gammaCDF returns the cumulative distribution function of the gamma
+// distribution with the given shape and scale.
+func gammaCDF(x, shape, scale float64) float64 {
+	if x <= 0 {
+		return 0
+	}
+	return regularizedGammaP(shape, x/scale)
+}
+
+// gammaQuantile returns the value below which a probability p of the
+// gamma distribution with the given shape and scale lies.
+func gammaQuantile(p, shape, scale float64) float64 {
+	if p <= 0 {
+		return 0
+	}
+	lo, hi := 0.0, shape*scale
+	for gammaCDF(hi, shape, scale) < p {
+		hi *= 2
+	}
+	for i := 0; i < 200 && hi-lo > 1e-10*hi; i++ {
+		mid := (lo + hi) / 2
+		if gammaCDF(mid, shape, scale) < p {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return (lo + hi) / 2
+}
+
+// regularizedGammaP is the regularized lower incomplete gamma function,
+// computed with its series expansion for small values of x and with its
+// continued fraction otherwise.
+//
+// See: Numerical Recipes in C, 6.2 Incomplete Gamma Function
+func regularizedGammaP(a, x float64) float64 {
+	const (
+		eps  = 1e-14
+		tiny = 1e-300
+	)
+	// Both expansions take a number of terms proportional to sqrt(a)
+	// to converge around the mean, which matters for the large shapes
+	// of the posteriors of the national numbers.
+	maxIter := 1000 + int(10*math.Sqrt(a))
+	lg, _ := math.Lgamma(a)
+	if x < a+1 {
+		sum := 1 / a
+		del := sum
+		for n := 1; n < maxIter; n++ {
+			del *= x / (a + float64(n))
+			sum += del
+			if math.Abs(del) < math.Abs(sum)*eps {
+				break
+			}
+		}
+		return sum * math.Exp(-x+a*math.Log(x)-lg)
+	}
+
+	// Lentz's method for the continued fraction of Q(a, x).
+	b := x + 1 - a
+	c := 1 / tiny
+	d := 1 / b
+	h := d
+	for n := 1; n < maxIter; n++ {
+		an := -float64(n) * (float64(n) - a)
+		b += 2
+		d = an*d + b
+		if math.Abs(d) < tiny {
+			d = tiny
+		}
+		c = b + an/c
+		if math.Abs(c) < tiny {
+			c = tiny
+		}
+		d = 1 / d
+		del := d * c
+		h *= del
+		if math.Abs(del-1) < eps {
+			break
+		}
+	}
+	return 1 - math.Exp(-x+a*math.Log(x)-lg)*h
+}
@@ -0,0 +1,92 @@
+package analysis
+
+import (
+	"math"
+	"time"
+)
+
+// Growth is the exponential growth of a cumulative number of cases.
+type Growth struct {
+	// Rate is the daily growth rate, e.g. 0.05 for 5% per day.
+	Rate float64 `json:"rate"`
+
+	// DoublingTime is the number of days it takes for the cases to
+	// double, or 0 in case the cases are not growing.
+	DoublingTime float64 `json:"doubling_time"`
+}
+
+// StateGrowth has the growth of the positive cases and deaths of a state
+// over the latest window of days and the one before it.
+type StateGrowth struct {
+	Name             string    `json:"name"`
+	Date             time.Time `json:"date"`
+	Positive         Growth    `json:"positive"`
+	PreviousPositive Growth    `json:"previous_positive"`
+	Deaths           Growth    `json:"deaths"`
+	PreviousDeaths   Growth    `json:"previous_deaths"`
+
+	// Shortened is set when the doubling time of either the positive
+	// cases or the deaths got shorter than in the previous window.
+	Shortened bool `json:"shortened"`
+}
+
+// GrowthRate fits an exponential curve to the cumulative values of the
+// window of days that ends at the given position.
+func GrowthRate(days []Day, end, window int, value func(Day) int) Growth {
+	start := end - window
+	if start < 0 {
+		start = 0
+	}
+	var n, sx, sy, sxx, sxy float64
+	for i := start; i <= end && i < len(days); i++ {
+		v := value(days[i])
+		if v <= 0 {
+			continue
+		}
+		x, y := float64(i-start), math.Log(float64(v))
+		n++
+		sx += x
+		sy += y
+		sxx += x * x
+		sxy += x * y
+	}
+	if n < 2 || n*sxx-sx*sx == 0 {
+		return Growth{}
+	}
+	rate := (n*sxy - sx*sy) / (n*sxx - sx*sx)
+	g := Growth{Rate: math.Exp(rate) - 1}
+	if rate > 0 {
+		g.DoublingTime = math.Ln2 / rate
+	}
+	return g
+}
+
+// ComputeGrowth computes the growth of a state over the latest window
+// of days and compares it with the previous window.
+func ComputeGrowth(s StateSeries, window int) StateGrowth {
+	sg := StateGrowth{Name: s.Name}
+	if len(s.Days) == 0 {
+		return sg
+	}
+	end := len(s.Days) - 1
+	positive := func(d Day) int { return d.Positive }
+	deaths := func(d Day) int { return d.Deaths }
+
+	sg.Date = s.Days[end].Date
+	sg.Positive = GrowthRate(s.Days, end, window, positive)
+	sg.Deaths = GrowthRate(s.Days, end, window, deaths)
+	if end-window >= 0 {
+		sg.PreviousPositive = GrowthRate(s.Days, end-window, window, positive)
+		sg.PreviousDeaths = GrowthRate(s.Days, end-window, window, deaths)
+		sg.Shortened = shortened(sg.PreviousPositive, sg.Positive) || shortened(sg.PreviousDeaths, sg.Deaths)
+	}
+	return sg
+}
+
+// shortened reports whether the cases are now doubling faster.
+func shortened(prev, cur Growth) bool {
+	if cur.DoublingTime == 0 {
+		return false
+	}
+	return prev.DoublingTime == 0 || cur.DoublingTime < prev.DoublingTime
+}
@@ -0,0 +1,98 @@
+package analysis
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+// growthDays returns cumulative positive cases and deaths that grow by
+// the given daily rates, one after the other.
+func growthDays(n int, rates ...float64) []Day {
+	start := time.Date(2020, 4, 1, 0, 0, 0, 0, time.UTC)
+	days := make([]Day, 0, n*len(rates))
+	v := 1e6
+	for _, rate := range rates {
+		for i := 0; i < n; i++ {
+			days = append(days, Day{
+				Date:     start.AddDate(0, 0, len(days)),
+				Positive: int(math.Round(v)),
+				Deaths:   int(math.Round(v / 10)),
+			})
+			v *= 1 + rate
+		}
+	}
+	return days
+}
+
+func TestGrowthRate(t *testing.T) {
+	positive := func(d Day) int { return d.Positive }
+	for _, tt := range []struct {
+		name     string
+		days     []Day
+		rate     float64
+		doubling float64
+	}{
+		{"doubling every day", growthDays(8, 1), 1, 1},
+		{"10% per day", growthDays(8, 0.1), 0.1, math.Ln2 / math.Log(1.1)},
+		{"flat", growthDays(8, 0), 0, 0},
+		{"decreasing", growthDays(8, -0.1), -0.1, 0},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			g := GrowthRate(tt.days, len(tt.days)-1, 7, positive)
+			if !near(g.Rate, tt.rate, 1e-3) || !near(g.DoublingTime, tt.doubling, 1e-2) {
+				t.Errorf("got %+v, want rate %.4f and doubling time %.4f", g, tt.rate, tt.doubling)
+			}
+		})
+	}
+}
+
+func TestGrowthRateSkipsEmptyDays(t *testing.T) {
+	positive := func(d Day) int { return d.Positive }
+	days := growthDays(8, 0.1)
+	days[3].Positive = 0
+	if g := GrowthRate(days, 7, 7, positive); !near(g.Rate, 0.1, 1e-3) {
+		t.Errorf("got rate %.4f, want 0.1", g.Rate)
+	}
+
+	// At least two days with cases are needed.
+	empty := make([]Day, 8)
+	empty[7].Positive = 10
+	if g := GrowthRate(empty, 7, 7, positive); g != (Growth{}) {
+		t.Errorf("got %+v from a single day, want no growth", g)
+	}
+}
+
+func TestComputeGrowth(t *testing.T) {
+	for _, tt := range []struct {
+		name      string
+		days      []Day
+		shortened bool
+	}{
+		{"faster", growthDays(8, 0.05, 0.2), true},
+		{"slower", growthDays(8, 0.2, 0.05), false},
+		{"same", growthDays(16, 0.1), false},
+		{"growing again", growthDays(8, 0, 0.1), true},
+		{"stopped", growthDays(8, 0.1, 0), false},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			sg := ComputeGrowth(StateSeries{Name: "Jalisco", Days: tt.days}, 7)
+			if sg.Name != "Jalisco" || !sg.Date.Equal(tt.days[len(tt.days)-1].Date) {
+				t.Errorf("got %s on %s", sg.Name, sg.Date)
+			}
+			if sg.Shortened != tt.shortened {
+				t.Errorf("got shortened %t, want %t (doubling time %.2f, previous %.2f)",
+					sg.Shortened, tt.shortened, sg.Positive.DoublingTime, sg.PreviousPositive.DoublingTime)
+			}
+		})
+	}
+
+	// There is no previous window to compare with.
+	sg := ComputeGrowth(StateSeries{Days: growthDays(5, 0.2)}, 7)
+	if sg.Shortened || sg.PreviousPositive != (Growth{}) {
+		t.Errorf("got %+v without a previous window", sg)
+	}
+	if sg := ComputeGrowth(StateSeries{Name: "Jalisco"}, 7); sg.Positive != (Growth{}) {
+		t.Errorf("got %+v without days", sg)
+	}
+}
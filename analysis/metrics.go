@@ -0,0 +1,219 @@
+package analysis
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/wallyqs/covid19mx/archive"
+	"github.com/wallyqs/covid19mx/geo"
+	"github.com/wallyqs/covid19mx/sinave"
+)
+
+// Metrics are the rates derived from the number of cases of a state or
+// municipio.
+type Metrics struct {
+	// CFR is the case fatality rate, deaths over positive cases.
+	CFR float64 `json:"cfr"`
+
+	// Positivity is the ratio of positive tests.
+	Positivity float64 `json:"positivity"`
+
+	// SuspectShare is the ratio of the cases that are still suspect.
+	SuspectShare float64 `json:"suspect_share"`
+
+	// CasesPer100k is the number of positive cases per 100,000
+	// inhabitants, or 0 in case the population is not known.
+	CasesPer100k float64 `json:"cases_per_100k"`
+
+	// DeathsPer100k is the number of deaths per 100,000 inhabitants,
+	// or 0 in case the population is not known.
+	DeathsPer100k float64 `json:"deaths_per_100k"`
+
+	// HasPopulation is set when the population is known, otherwise the
+	// metrics per 100k are not meaningful.
+	HasPopulation bool `json:"has_population"`
+}
+
+// ComputeMetrics computes the metrics from the number of cases and the
+// population, the population can be 0 if it is not known.
+func ComputeMetrics(positive, negative, suspect, deaths int, population float64) Metrics {
+	var m Metrics
+	if positive > 0 {
+		m.CFR = float64(deaths) / float64(positive)
+	}
+	if positive+negative > 0 {
+		m.Positivity = float64(positive) / float64(positive+negative)
+	}
+	if positive+negative+suspect > 0 {
+		m.SuspectShare = float64(suspect) / float64(positive+negative+suspect)
+	}
+	if population > 0 {
+		m.HasPopulation = true
+		m.CasesPer100k = float64(positive) * 100000 / population
+		m.DeathsPer100k = float64(deaths) * 100000 / population
+	}
+	return m
+}
+
+// StatePopulation returns the population of a state given its name, or
+// 0 in case it is not known.
+func StatePopulation(name string) float64 {
+	code, ok := geo.StateCode(name)
+	if !ok {
+		return 0
+	}
+	pop, _ := geo.Population(code)
+	return float64(pop)
+}
+
+// TotalPopulation returns the population of the states listed in sdata,
+// which is the national population unless the states were filtered, or 0
+// in case the population of any of them is not known.
+func TotalPopulation(sdata *sinave.SinaveData) float64 {
+	var total float64
+	for _, state := range sdata.States {
+		if state.Name == "NACIONAL" {
+			continue
+		}
+		pop := StatePopulation(state.Name)
+		if pop <= 0 {
+			return 0
+		}
+		total += pop
+	}
+	return total
+}
+
+// MunicipioPopulation returns the population of a municipio given its
+// code, or 0 in case it is not known.
+func MunicipioPopulation(code string) float64 {
+	pop, _ := geo.Population(code)
+	return float64(pop)
+}
+
+// Metric is one of the metrics that can be selected for display.
+type Metric struct {
+	Name  string
+	Title string
+	Value func(Metrics) float64
+
+	// PerCapita is set for the metrics that need the population.
+	PerCapita bool
+}
+
+// Known reports whether the metric can be computed from the data, the
+// metrics per capita are not known without the population.
+func (m Metric) Known(metrics Metrics) bool {
+	return !m.PerCapita || metrics.HasPopulation
+}
+
+// AllMetrics are the metrics that can be selected, in display order.
+var AllMetrics = []Metric{
+	{"cfr", "Letalidad", func(m Metrics) float64 { return m.CFR }, false},
+	{"positivity", "Positividad", func(m Metrics) float64 { return m.Positivity }, false},
+	{"suspect", "Sospechosos", func(m Metrics) float64 { return m.SuspectShare }, false},
+	{"cases100k", "Casos/100k", func(m Metrics) float64 { return m.CasesPer100k }, true},
+	{"deaths100k", "Decesos/100k", func(m Metrics) float64 { return m.DeathsPer100k }, true},
+}
+
+// ParseMetrics parses a comma separated list of metric names, an empty
+// list selects all the metrics.
+func ParseMetrics(s string) ([]Metric, error) {
+	if s == "" || s == "all" {
+		return AllMetrics, nil
+	}
+	selected := make([]Metric, 0)
+	for _, name := range strings.Split(s, ",") {
+		name = strings.TrimSpace(name)
+		found := false
+		for _, m := range AllMetrics {
+			if m.Name == name {
+				selected = append(selected, m)
+				found = true
+				break
+			}
+		}
+		if !found {
+			names := make([]string, 0)
+			for _, m := range AllMetrics {
+				names = append(names, m.Name)
+			}
+			return nil, fmt.Errorf("Unknown metric %q (options: %s)", name, strings.Join(names, ", "))
+		}
+	}
+	return selected, nil
+}
+
+// MetricsRow has the metrics of a state or municipio, the date is only
+// set for the rows of a time series.
+type MetricsRow struct {
+	Name    string
+	Date    string
+	Metrics Metrics
+}
+
+// StateMetrics computes the metrics of every state followed by the
+// national ones.
+func StateMetrics(sdata *sinave.SinaveData) []MetricsRow {
+	rows := make([]MetricsRow, 0)
+	for _, state := range sdata.States {
+		if state.Name == "NACIONAL" {
+			continue
+		}
+		rows = append(rows, MetricsRow{
+			Name: state.Name,
+			Metrics: ComputeMetrics(state.PositiveCases, state.NegativeCases,
+				state.SuspectCases, state.Deaths, StatePopulation(state.Name)),
+		})
+	}
+	name := National
+	if sdata.Partial {
+		name = sdata.TotalName()
+	}
+	rows = append(rows, MetricsRow{
+		Name: name,
+		Metrics: ComputeMetrics(sdata.TotalPositiveCases(), sdata.TotalNegativeCases(),
+			sdata.TotalSuspectCases(), sdata.TotalDeaths(), TotalPopulation(sdata)),
+	})
+	return rows
+}
+
+// MunicipioMetrics computes the metrics of every municipio keyed by its
+// code, in the given order.
+func MunicipioMetrics(muns map[string]sinave.Municipio, order *Order) []MetricsRow {
+	codes := order.Municipios(muns)
+
+	rows := make([]MetricsRow, 0, len(codes))
+	for _, code := range codes {
+		m := muns[code]
+		name := m.Name
+		if state := geo.StateName(code[:2]); state != "" && state != m.Name {
+			name = fmt.Sprintf("%s, %s", m.Name, state)
+		}
+		rows = append(rows, MetricsRow{
+			Name:    name,
+			Metrics: ComputeMetrics(m.PositiveCases, m.NegativeCases, m.SuspectCases, m.Deaths, MunicipioPopulation(code)),
+		})
+	}
+	return rows
+}
+
+// MetricsSeries computes the metrics of a state on each day of the
+// archive, or the national ones in case the name is National.
+func MetricsSeries(store *archive.Store, name string) []MetricsRow {
+	rows := make([]MetricsRow, 0)
+	for _, date := range store.Dates() {
+		sdata, err := store.Snapshot(date)
+		if err != nil {
+			continue
+		}
+		for _, row := range StateMetrics(sdata) {
+			if row.Name != name {
+				continue
+			}
+			row.Date = date.Format(archive.DateLayout)
+			rows = append(rows, row)
+		}
+	}
+	return rows
+}
@@ -0,0 +1,96 @@
+package analysis
+
+import (
+	"sort"
+
+	"github.com/wallyqs/covid19mx/geo"
+	"github.com/wallyqs/covid19mx/sinave"
+)
+
+// Reconciliation compares the sum of the municipios of a state with the
+// numbers published for the state.
+type Reconciliation struct {
+	Code string `json:"code"`
+	Name string `json:"name"`
+
+	// Municipal is the sum of the municipios of the state.
+	Municipal sinave.State `json:"municipal"`
+
+	// Official are the numbers published at the state level, which are
+	// missing when HasOfficial is not set.
+	Official    sinave.State `json:"official"`
+	HasOfficial bool         `json:"has_official"`
+
+	// UnknownCodes are the codes of the municipios of the state that are
+	// missing from geo.MunicipiosMexico, as they were published.
+	UnknownCodes []string `json:"unknown_codes"`
+
+	// Mismatch is set when the municipal sum differs from the official
+	// numbers in any of the case types.
+	Mismatch bool `json:"mismatch"`
+}
+
+// Reconcile compares the municipal data rolled up per state with the
+// state level data, sorted by the code of the state. States that only
+// appear at one of the levels are included as well.
+func Reconcile(muns map[string]sinave.Municipio, sdata *sinave.SinaveData) []*Reconciliation {
+	byCode := make(map[string]*Reconciliation)
+	get := func(code string) *Reconciliation {
+		r, ok := byCode[code]
+		if !ok {
+			r = &Reconciliation{
+				Code:         code,
+				Name:         geo.StateName(code),
+				UnknownCodes: make([]string, 0),
+			}
+			r.Municipal.Name = r.Name
+			byCode[code] = r
+		}
+		return r
+	}
+
+	for code, m := range muns {
+		// Unknown codes are counted in their bucket, which also keeps
+		// the codes that are too short to have a state apart.
+		r := get(geo.Bucket(code)[:2])
+		r.Municipal.PositiveCases += m.PositiveCases
+		r.Municipal.NegativeCases += m.NegativeCases
+		r.Municipal.SuspectCases += m.SuspectCases
+		r.Municipal.Deaths += m.Deaths
+		if _, ok := geo.MunicipiosMexico[code]; !ok {
+			r.UnknownCodes = append(r.UnknownCodes, code)
+		}
+	}
+	for _, state := range sdata.States {
+		if state.Name == National {
+			continue
+		}
+		code, ok := geo.StateCode(state.Name)
+		if !ok {
+			// Keep the states with unexpected names apart.
+			code = state.Name
+		}
+		r := get(code)
+		if r.Name == "" {
+			r.Name = state.Name
+		}
+		r.Official = state
+		r.HasOfficial = true
+	}
+
+	result := make([]*Reconciliation, 0, len(byCode))
+	for _, r := range byCode {
+		sort.Strings(r.UnknownCodes)
+		m, o := r.Municipal, r.Official
+		r.Mismatch = !r.HasOfficial ||
+			m.PositiveCases != o.PositiveCases ||
+			m.NegativeCases != o.NegativeCases ||
+			m.SuspectCases != o.SuspectCases ||
+			m.Deaths != o.Deaths
+		result = append(result, r)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Code < result[j].Code
+	})
+	return result
+}
@@ -0,0 +1,131 @@
+package analysis
+
+import (
+	"time"
+)
+
+// SerialInterval is the distribution of the time between the onset of
+// symptoms of a case and the onset of symptoms of the cases it infects,
+// modeled as a gamma distribution with the given mean and standard
+// deviation in days.
+type SerialInterval struct {
+	Mean float64
+	SD   float64
+}
+
+// DefaultSerialInterval is the serial interval estimated for COVID19.
+//
+// See: Nishiura H, Linton NM, Akhmetzhanov AR. Serial interval of novel
+// coronavirus (COVID-19) infections. Int J Infect Dis. 2020
+var DefaultSerialInterval = SerialInterval{Mean: 4.7, SD: 2.9}
+
+// Weights discretizes the serial interval into the probability of each
+// day from 1 up to max days, normalized to add up to 1.
+func (si SerialInterval) Weights(max int) []float64 {
+	shape := (si.Mean * si.Mean) / (si.SD * si.SD)
+	scale := (si.SD * si.SD) / si.Mean
+
+	w := make([]float64, max+1)
+	var total float64
+	for s := 1; s <= max; s++ {
+		w[s] = gammaCDF(float64(s), shape, scale) - gammaCDF(float64(s-1), shape, scale)
+		total += w[s]
+	}
+	for s := range w {
+		w[s] /= total
+	}
+	return w
+}
+
+// RtOptions customizes how the reproduction number is estimated.
+type RtOptions struct {
+	SerialInterval SerialInterval
+
+	// Window is the number of days over which Rt is assumed constant.
+	Window int
+
+	// CredibleLevel is the probability covered by the credible
+	// interval, e.g. 0.95.
+	CredibleLevel float64
+
+	// PriorMean and PriorSD are the parameters of the gamma prior
+	// for Rt.
+	PriorMean float64
+	PriorSD   float64
+}
+
+// DefaultRtOptions are the options used by EpiEstim by default.
+var DefaultRtOptions = RtOptions{
+	SerialInterval: DefaultSerialInterval,
+	Window:         7,
+	CredibleLevel:  0.95,
+	PriorMean:      5,
+	PriorSD:        5,
+}
+
+// RtEstimate is the estimate of the effective reproduction number at
+// the end of a window of days.
+type RtEstimate struct {
+	Date  time.Time `json:"date"`
+	Mean  float64   `json:"mean"`
+	Lower float64   `json:"lower"`
+	Upper float64   `json:"upper"`
+	Cases int       `json:"cases"`
+}
+
+// StateRt has the Rt estimates of a state, or of the whole country.
+type StateRt struct {
+	Name      string       `json:"name"`
+	Estimates []RtEstimate `json:"estimates"`
+}
+
+// EstimateRt estimates the effective reproduction number from the new
+// positive cases per day, using the method from Cori et al.
+//
+// See: Cori A, Ferguson NM, Fraser C, Cauchemez S. A New Framework and
+// Software to Estimate Time-Varying Reproduction Numbers During
+// Epidemics. Am J Epidemiol. 2013
+func EstimateRt(days []Day, opts RtOptions) []RtEstimate {
+	incidence := make([]float64, len(days))
+	for i, d := range days {
+		// Corrections can make the new cases negative.
+		if d.NewPositive > 0 {
+			incidence[i] = float64(d.NewPositive)
+		}
+	}
+	w := opts.SerialInterval.Weights(len(days))
+
+	// Total infectiousness of the previous cases on each day.
+	lambda := make([]float64, len(days))
+	for t := range days {
+		for s := 1; s <= t; s++ {
+			lambda[t] += incidence[t-s] * w[s]
+		}
+	}
+
+	priorShape := (opts.PriorMean * opts.PriorMean) / (opts.PriorSD * opts.PriorSD)
+	priorScale := (opts.PriorSD * opts.PriorSD) / opts.PriorMean
+	tail := (1 - opts.CredibleLevel) / 2
+
+	estimates := make([]RtEstimate, 0)
+	for t := opts.Window; t < len(days); t++ {
+		var cases, infectiousness float64
+		for k := t - opts.Window + 1; k <= t; k++ {
+			cases += incidence[k]
+			infectiousness += lambda[k]
+		}
+		if infectiousness == 0 {
+			continue
+		}
+		shape := priorShape + cases
+		scale := 1 / (1/priorScale + infectiousness)
+		estimates = append(estimates, RtEstimate{
+			Date:  days[t].Date,
+			Mean:  shape * scale,
+			Lower: gammaQuantile(tail, shape, scale),
+			Upper: gammaQuantile(1-tail, shape, scale),
+			Cases: int(cases),
+		})
+	}
+	return estimates
+}
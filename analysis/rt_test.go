@@ -0,0 +1,162 @@
+package analysis
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func near(got, want, tol float64) bool {
+	return math.Abs(got-want) <= tol*math.Max(1, math.Abs(want))
+}
+
+func TestGammaCDF(t *testing.T) {
+	for _, tt := range []struct {
+		x, shape, scale float64
+		want            float64
+	}{
+		// Exponential distribution: 1 - e^-x.
+		{1, 1, 1, 0.6321205588285577},
+		{5, 1, 1, 0.9932620530009145},
+		// Erlang distribution: 1 - e^-x (1 + x).
+		{3, 2, 1, 0.8008517265285442},
+		// Chi-square with 4 degrees of freedom at its 0.95 quantile.
+		{9.487729, 2, 2, 0.95},
+		{0, 2, 1, 0},
+	} {
+		if got := gammaCDF(tt.x, tt.shape, tt.scale); !near(got, tt.want, 1e-6) {
+			t.Errorf("gammaCDF(%g, %g, %g) = %.10f, want %.10f", tt.x, tt.shape, tt.scale, got, tt.want)
+		}
+	}
+}
+
+func TestGammaQuantile(t *testing.T) {
+	// Quantiles of the chi-square distribution with k degrees of
+	// freedom, which is a gamma distribution with shape k/2 and scale 2.
+	for _, tt := range []struct {
+		p    float64
+		k    float64
+		want float64
+	}{
+		{0.975, 1, 5.023886},
+		{0.025, 10, 3.246973},
+		{0.975, 10, 20.483177},
+		{0.5, 2, 1.386294},
+		{0.05, 100, 77.929465},
+	} {
+		if got := gammaQuantile(tt.p, tt.k/2, 2); !near(got, tt.want, 1e-6) {
+			t.Errorf("chi-square %g quantile with %g degrees of freedom = %.6f, want %.6f", tt.p, tt.k, got, tt.want)
+		}
+	}
+}
+
+func TestGammaQuantileLargeShape(t *testing.T) {
+	// The Wilson-Hilferty approximation is accurate for large shapes,
+	// z are the quantiles of the standard normal distribution.
+	for _, shape := range []float64{1e4, 1e6, 1e8} {
+		for _, q := range []struct{ p, z float64 }{{0.025, -1.959963984540054}, {0.975, 1.959963984540054}} {
+			want := math.Pow(1-1/(9*shape)+q.z*math.Sqrt(1/(9*shape)), 3)
+			if got := gammaQuantile(q.p, shape, 1/shape); !near(got, want, 1e-7) {
+				t.Errorf("shape %g: got %g quantile %.9f, want %.9f", shape, q.p, got, want)
+			}
+		}
+	}
+}
+
+func TestSerialIntervalWeights(t *testing.T) {
+	w := DefaultSerialInterval.Weights(60)
+	if w[0] != 0 {
+		t.Errorf("got weight %g for day 0, want 0", w[0])
+	}
+	var total, mean float64
+	for s, ws := range w {
+		total += ws
+		mean += float64(s) * ws
+	}
+	if !near(total, 1, 1e-12) {
+		t.Errorf("weights add up to %g, want 1", total)
+	}
+	// Each day gets the probability of the day before it, which shifts
+	// the mean by half a day.
+	if !near(mean, DefaultSerialInterval.Mean+0.5, 1e-2) {
+		t.Errorf("got mean %.3f, want %.3f", mean, DefaultSerialInterval.Mean+0.5)
+	}
+}
+
+func rtDays(incidence []int) []Day {
+	start := time.Date(2020, 4, 1, 0, 0, 0, 0, time.UTC)
+	days := make([]Day, len(incidence))
+	for i, n := range incidence {
+		days[i] = Day{Date: start.AddDate(0, 0, i), NewPositive: n}
+	}
+	return days
+}
+
+// TestEstimateRtPosterior checks the gamma posterior of Cori et al. for
+// a window of a single day, where the credible interval is given by the
+// quantiles of a chi-square distribution with 2(a + cases) degrees of
+// freedom scaled by half the scale of the posterior.
+func TestEstimateRtPosterior(t *testing.T) {
+	opts := RtOptions{
+		SerialInterval: DefaultSerialInterval,
+		Window:         1,
+		CredibleLevel:  0.95,
+		// A flat exponential prior with shape 1.
+		PriorMean: 5,
+		PriorSD:   5,
+	}
+	estimates := EstimateRt(rtDays([]int{10, 4}), opts)
+	if len(estimates) != 1 {
+		t.Fatalf("got %d estimates, want 1", len(estimates))
+	}
+	got := estimates[0]
+
+	w := opts.SerialInterval.Weights(2)
+	scale := 1 / (1.0/5 + 10*w[1])
+	want := RtEstimate{
+		Mean:  5 * scale,
+		Lower: 3.246973 / 2 * scale,
+		Upper: 20.483177 / 2 * scale,
+		Cases: 4,
+	}
+	if !near(got.Mean, want.Mean, 1e-9) || !near(got.Lower, want.Lower, 1e-6) || !near(got.Upper, want.Upper, 1e-6) || got.Cases != want.Cases {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+}
+
+// TestEstimateRtGrowth checks the estimates against the reproduction
+// number of an epidemic growing at a constant rate r, which is
+// 1 / sum(w[s] e^(-r s)) as shown by Wallinga and Lipsitch (2007).
+func TestEstimateRtGrowth(t *testing.T) {
+	for _, r := range []float64{-0.03, 0, 0.08} {
+		incidence := make([]int, 60)
+		for i := range incidence {
+			incidence[i] = int(math.Round(1000 * math.Exp(r*float64(i))))
+		}
+		days := rtDays(incidence)
+		estimates := EstimateRt(days, DefaultRtOptions)
+		if len(estimates) != len(days)-DefaultRtOptions.Window {
+			t.Fatalf("r=%g: got %d estimates, want %d", r, len(estimates), len(days)-DefaultRtOptions.Window)
+		}
+
+		var m float64
+		for s, ws := range DefaultSerialInterval.Weights(len(days)) {
+			m += ws * math.Exp(-r*float64(s))
+		}
+		want := 1 / m
+		last := estimates[len(estimates)-1]
+		if !near(last.Mean, want, 5e-3) {
+			t.Errorf("r=%g: got Rt %.4f, want %.4f", r, last.Mean, want)
+		}
+		if !(last.Lower < want && want < last.Upper) || last.Upper-last.Lower > 0.1 {
+			t.Errorf("r=%g: got credible interval [%.4f, %.4f] around %.4f", r, last.Lower, last.Upper, last.Mean)
+		}
+	}
+}
+
+func TestEstimateRtSkipsDaysWithoutCases(t *testing.T) {
+	estimates := EstimateRt(rtDays([]int{0, 0, 0, 0, 0, 0, 0, 0, 0, 0}), DefaultRtOptions)
+	if len(estimates) != 0 {
+		t.Errorf("got %d estimates without cases, want none", len(estimates))
+	}
+}
@@ -0,0 +1,134 @@
+// Package analysis derives time series metrics, e.g. daily new cases and
+// their rolling averages, from the snapshots of the archive.
+package analysis
+
+import (
+	"time"
+
+	"github.com/wallyqs/covid19mx/archive"
+)
+
+// National is the name used for the series with the totals of the country.
+const National = "NACIONAL"
+
+// Day has the new cases reported on a single day.
+type Day struct {
+	Date        time.Time `json:"date"`
+	Positive    int       `json:"positive"`
+	Deaths      int       `json:"deaths"`
+	NewPositive int       `json:"new_positive"`
+	NewDeaths   int       `json:"new_deaths"`
+	NewTests    int       `json:"new_tests"`
+
+	// Rolling averages of the new cases.
+	Positive7d  float64 `json:"new_positive_7d"`
+	Positive14d float64 `json:"new_positive_14d"`
+	Deaths7d    float64 `json:"new_deaths_7d"`
+	Deaths14d   float64 `json:"new_deaths_14d"`
+	Tests7d     float64 `json:"new_tests_7d"`
+	Tests14d    float64 `json:"new_tests_14d"`
+
+	// Interpolated is set for the days that were missing from the
+	// archive, in which case the new cases reported by the next
+	// snapshot are spread evenly across the missing days.
+	Interpolated bool `json:"interpolated"`
+}
+
+// StateSeries is the daily series of a state, or of the whole country.
+type StateSeries struct {
+	Name string `json:"name"`
+	Days []Day  `json:"days"`
+}
+
+// Daily computes the new cases per day out of the cumulative numbers
+// from consecutive snapshots, along with their 7 and 14 day rolling
+// averages. The first days of the series are averaged over the days
+// that are available.
+func Daily(series archive.Series) []Day {
+	days := make([]Day, 0)
+	for i := 1; i < len(series); i++ {
+		prev, cur := series[i-1], series[i]
+		gap := int(cur.Date.Sub(prev.Date).Hours() / 24)
+		if gap < 1 {
+			continue
+		}
+		newPositive := spread(cur.PositiveCases-prev.PositiveCases, gap)
+		newDeaths := spread(cur.Deaths-prev.Deaths, gap)
+		newTests := spread((cur.PositiveCases+cur.NegativeCases)-(prev.PositiveCases+prev.NegativeCases), gap)
+		positive, deaths := prev.PositiveCases, prev.Deaths
+		for j := 0; j < gap; j++ {
+			positive += newPositive[j]
+			deaths += newDeaths[j]
+			days = append(days, Day{
+				Date:         prev.Date.AddDate(0, 0, j+1),
+				Positive:     positive,
+				Deaths:       deaths,
+				NewPositive:  newPositive[j],
+				NewDeaths:    newDeaths[j],
+				NewTests:     newTests[j],
+				Interpolated: j < gap-1,
+			})
+		}
+	}
+
+	for i := range days {
+		days[i].Positive7d = rolling(days, i, 7, func(d Day) int { return d.NewPositive })
+		days[i].Positive14d = rolling(days, i, 14, func(d Day) int { return d.NewPositive })
+		days[i].Deaths7d = rolling(days, i, 7, func(d Day) int { return d.NewDeaths })
+		days[i].Deaths14d = rolling(days, i, 14, func(d Day) int { return d.NewDeaths })
+		days[i].Tests7d = rolling(days, i, 7, func(d Day) int { return d.NewTests })
+		days[i].Tests14d = rolling(days, i, 14, func(d Day) int { return d.NewTests })
+	}
+	return days
+}
+
+// DailyByState computes the daily series for every state in the archive
+// followed by the national one.
+func DailyByState(store *archive.Store) []StateSeries {
+	result := make([]StateSeries, 0)
+	for _, name := range store.States() {
+		result = append(result, StateSeries{
+			Name: name,
+			Days: Daily(store.StateSeries(name)),
+		})
+	}
+	result = append(result, StateSeries{
+		Name: National,
+		Days: Daily(store.NationalSeries()),
+	})
+	return result
+}
+
+// spread distributes a number of cases evenly across a number of days,
+// with the remainder going to the last days.
+func spread(total, days int) []int {
+	result := make([]int, days)
+	for i := range result {
+		result[i] = total / days
+	}
+	rem := total % days
+	for i := 0; rem != 0; i++ {
+		if rem > 0 {
+			result[days-1-i]++
+			rem--
+		} else {
+			result[days-1-i]--
+			rem++
+		}
+	}
+	return result
+}
+
+// rolling returns the average of the values from the window of days
+// that ends at the given position.
+func rolling(days []Day, end, window int, value func(Day) int) float64 {
+	start := end - window + 1
+	if start < 0 {
+		start = 0
+	}
+	var sum int
+	for _, d := range days[start : end+1] {
+		sum += value(d)
+	}
+	return float64(sum) / float64(end-start+1)
+}
@@ -0,0 +1,233 @@
+package analysis
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/wallyqs/covid19mx/geo"
+	"github.com/wallyqs/covid19mx/sinave"
+)
+
+// SortKeys are the keys by which the rows of a report can be sorted.
+var SortKeys = []string{"code", "name", "positive", "deaths", "positivity", "cases100k"}
+
+// Order is the order in which the rows of a report are shown. A nil
+// Order shows every row sorted by code.
+type Order struct {
+	Key  string
+	Desc bool
+
+	// Top is the number of rows shown, all of them when 0.
+	Top int
+}
+
+// ParseOrder parses a sort key optionally followed by ":asc" or ":desc"
+// (e.g. "deaths:desc"). Codes and names are sorted in ascending order by
+// default and the numbers in descending order.
+func ParseOrder(s string, top int) (*Order, error) {
+	if top < 0 {
+		return nil, fmt.Errorf("Invalid number of rows %d", top)
+	}
+	if s == "" {
+		s = "code"
+	}
+	key, dir := s, ""
+	if i := strings.IndexByte(s, ':'); i >= 0 {
+		key, dir = s[:i], s[i+1:]
+	}
+	key = strings.ToLower(strings.TrimSpace(key))
+
+	var valid bool
+	for _, k := range SortKeys {
+		valid = valid || k == key
+	}
+	if !valid {
+		return nil, fmt.Errorf("Unknown sort key %q (options: %s)", key, strings.Join(SortKeys, ", "))
+	}
+	o := &Order{Key: key, Top: top}
+	switch strings.ToLower(strings.TrimSpace(dir)) {
+	case "":
+		o.Desc = key != "code" && key != "name"
+	case "asc":
+	case "desc":
+		o.Desc = true
+	default:
+		return nil, fmt.Errorf("Unknown sort direction %q (options: asc, desc)", dir)
+	}
+	return o, nil
+}
+
+// Row has the values by which a row of a report is sorted.
+type Row struct {
+	Code       string
+	Name       string
+	Positive   int
+	Negative   int
+	Deaths     int
+	Population float64
+}
+
+// Sort returns the indexes of the rows in order, only the first Top of
+// them when it is set. Ties are broken by code.
+func (o *Order) Sort(rows []Row) []int {
+	idx := make([]int, len(rows))
+	for i := range idx {
+		idx[i] = i
+	}
+	if o == nil {
+		o = &Order{Key: "code"}
+	}
+	less := func(a, b Row) bool {
+		switch o.Key {
+		case "name":
+			return geo.Normalize(a.Name) < geo.Normalize(b.Name)
+		case "positive":
+			return a.Positive < b.Positive
+		case "deaths":
+			return a.Deaths < b.Deaths
+		case "positivity":
+			return a.metrics().Positivity < b.metrics().Positivity
+		case "cases100k":
+			return a.metrics().CasesPer100k < b.metrics().CasesPer100k
+		}
+		return a.Code < b.Code
+	}
+	sort.SliceStable(idx, func(i, j int) bool {
+		a, b := rows[idx[i]], rows[idx[j]]
+		switch {
+		case less(a, b):
+			return !o.Desc
+		case less(b, a):
+			return o.Desc
+		}
+		return a.Code < b.Code
+	})
+	if o.Top > 0 && o.Top < len(idx) {
+		idx = idx[:o.Top]
+	}
+	return idx
+}
+
+// States returns the state level data in order, the national row is
+// only kept when every state is shown and otherwise the data is marked
+// as Partial.
+func (o *Order) States(sdata *sinave.SinaveData) *sinave.SinaveData {
+	states := make([]sinave.State, 0, len(sdata.States))
+	var national []sinave.State
+	for _, state := range sdata.States {
+		if state.Name == "NACIONAL" {
+			national = append(national, state)
+			continue
+		}
+		states = append(states, state)
+	}
+
+	rows := make([]Row, len(states))
+	for i, state := range states {
+		rows[i] = Row{
+			Code:       stateCode(state.Name),
+			Name:       state.Name,
+			Positive:   state.PositiveCases,
+			Negative:   state.NegativeCases,
+			Deaths:     state.Deaths,
+			Population: StatePopulation(state.Name),
+		}
+	}
+	idx := o.Sort(rows)
+
+	sorted := &sinave.SinaveData{
+		States:  make([]sinave.State, 0, len(idx)+len(national)),
+		Partial: sdata.Partial || len(idx) < len(states),
+	}
+	for _, i := range idx {
+		sorted.States = append(sorted.States, states[i])
+	}
+	if len(idx) == len(states) {
+		sorted.States = append(sorted.States, national...)
+	}
+	return sorted
+}
+
+// Partial reports whether only some of n rows are shown.
+func (o *Order) Partial(n int) bool {
+	return o != nil && o.Top > 0 && o.Top < n
+}
+
+// Municipios returns the codes of the municipios in order.
+func (o *Order) Municipios(muns map[string]sinave.Municipio) []string {
+	codes := make([]string, 0, len(muns))
+	for code := range muns {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+
+	rows := make([]Row, len(codes))
+	for i, code := range codes {
+		rows[i] = MunicipioRow(code, muns[code])
+	}
+	idx := o.Sort(rows)
+
+	sorted := make([]string, len(idx))
+	for i, j := range idx {
+		sorted[i] = codes[j]
+	}
+	return sorted
+}
+
+// Reconciliations returns the reconciled states in order, by the
+// official numbers or by the municipal ones when a state has none.
+func (o *Order) Reconciliations(recs []*Reconciliation) []*Reconciliation {
+	rows := make([]Row, len(recs))
+	for i, r := range recs {
+		state := r.Official
+		if !r.HasOfficial {
+			state = r.Municipal
+		}
+		rows[i] = Row{
+			Code:       r.Code,
+			Name:       r.Name,
+			Positive:   state.PositiveCases,
+			Negative:   state.NegativeCases,
+			Deaths:     state.Deaths,
+			Population: StatePopulation(r.Name),
+		}
+	}
+	idx := o.Sort(rows)
+
+	sorted := make([]*Reconciliation, len(idx))
+	for i, j := range idx {
+		sorted[i] = recs[j]
+	}
+	return sorted
+}
+
+// MunicipioRow returns the values by which a municipio is sorted.
+func MunicipioRow(code string, m sinave.Municipio) Row {
+	return Row{
+		Code:       code,
+		Name:       m.Name,
+		Positive:   m.PositiveCases,
+		Negative:   m.NegativeCases,
+		Deaths:     m.Deaths,
+		Population: MunicipioPopulation(code),
+	}
+}
+
+// stateCode returns the code of a state given its name, states with
+// unexpected names are sorted after the rest.
+func stateCode(name string) string {
+	if code, ok := geo.StateCode(name); ok {
+		return code
+	}
+	if name == geo.ForeignName {
+		return geo.ForeignState
+	}
+	return name
+}
+
+// metrics computes the metrics by which a row can be sorted, the rows
+// without population are sorted as if they had no cases per 100k.
+func (r Row) metrics() Metrics {
+	return ComputeMetrics(r.Positive, r.Negative, 0, r.Deaths, r.Population)
+}
@@ -0,0 +1,245 @@
+// Package archive loads the daily snapshots from the data/ directory,
+// where each file is named after the day in which the data was
+// published (e.g. data/2020-05-12.json), into a time series.
+package archive
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/wallyqs/covid19mx/sinave"
+)
+
+// DateLayout is the layout used to name the snapshots from the archive.
+const DateLayout = "2006-01-02"
+
+var (
+	ErrSnapshotNotFound = errors.New("Could not find snapshot in archive!")
+)
+
+// Point has the number of cases reported on a given day.
+type Point struct {
+	Date          time.Time
+	PositiveCases int
+	NegativeCases int
+	SuspectCases  int
+	Deaths        int
+	AttackRate    float64
+}
+
+// Series is a list of points sorted by date.
+type Series []Point
+
+// At returns the point from the series for the given day.
+func (s Series) At(date time.Time) (Point, bool) {
+	i := sort.Search(len(s), func(i int) bool {
+		return !s[i].Date.Before(date)
+	})
+	if i < len(s) && s[i].Date.Equal(date) {
+		return s[i], true
+	}
+	return Point{}, false
+}
+
+// Store has the snapshots from an archive directory.
+type Store struct {
+	dir       string
+	dates     []time.Time
+	snapshots map[time.Time]*sinave.SinaveData
+}
+
+// Open loads all the snapshots from a directory. Both the JSON files
+// with and without attack rates and the CSV files are supported, in
+// case there are both for the same day the JSON one is used.
+func Open(dir string) (*Store, error) {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	store := &Store{
+		dir:       dir,
+		snapshots: make(map[time.Time]*sinave.SinaveData),
+	}
+	for _, f := range files {
+		if f.IsDir() {
+			continue
+		}
+		ext := filepath.Ext(f.Name())
+		if ext != ".json" && ext != ".csv" {
+			continue
+		}
+		date, ok := FileDate(f.Name())
+		if !ok {
+			// Not a snapshot.
+			continue
+		}
+		if _, ok := store.snapshots[date]; ok && ext == ".csv" {
+			continue
+		}
+		sdata, err := ReadFile(filepath.Join(dir, f.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", f.Name(), err)
+		}
+		if _, ok := store.snapshots[date]; !ok {
+			store.dates = append(store.dates, date)
+		}
+		store.snapshots[date] = sdata
+	}
+	sort.Slice(store.dates, func(i, j int) bool {
+		return store.dates[i].Before(store.dates[j])
+	})
+	return store, nil
+}
+
+// Dir returns the directory from where the snapshots were loaded.
+func (s *Store) Dir() string {
+	return s.dir
+}
+
+// Dates returns the days for which there is a snapshot, sorted.
+func (s *Store) Dates() []time.Time {
+	return s.dates
+}
+
+// Snapshot returns the data from the given day.
+func (s *Store) Snapshot(date time.Time) (*sinave.SinaveData, error) {
+	sdata, ok := s.snapshots[truncate(date)]
+	if !ok {
+		return nil, fmt.Errorf("%s: %w", date.Format(DateLayout), ErrSnapshotNotFound)
+	}
+	return sdata, nil
+}
+
+// Latest returns the most recent snapshot and its date.
+func (s *Store) Latest() (*sinave.SinaveData, time.Time, error) {
+	if len(s.dates) == 0 {
+		return nil, time.Time{}, ErrSnapshotNotFound
+	}
+	date := s.dates[len(s.dates)-1]
+	return s.snapshots[date], date, nil
+}
+
+// States returns the names of the states found in the archive, sorted.
+func (s *Store) States() []string {
+	seen := make(map[string]bool)
+	names := make([]string, 0)
+	for _, sdata := range s.snapshots {
+		for _, state := range sdata.States {
+			if state.Name == "NACIONAL" || seen[state.Name] {
+				continue
+			}
+			seen[state.Name] = true
+			names = append(names, state.Name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// StateSeries returns the time series for a single state.
+func (s *Store) StateSeries(name string) Series {
+	series := make(Series, 0, len(s.dates))
+	for _, date := range s.dates {
+		for _, state := range s.snapshots[date].States {
+			if state.Name != name {
+				continue
+			}
+			series = append(series, Point{
+				Date:          date,
+				PositiveCases: state.PositiveCases,
+				NegativeCases: state.NegativeCases,
+				SuspectCases:  state.SuspectCases,
+				Deaths:        state.Deaths,
+				AttackRate:    state.AttackRate,
+			})
+			break
+		}
+	}
+	return series
+}
+
+// NationalSeries returns the time series with the totals of the country.
+func (s *Store) NationalSeries() Series {
+	series := make(Series, 0, len(s.dates))
+	for _, date := range s.dates {
+		sdata := s.snapshots[date]
+		series = append(series, Point{
+			Date:          date,
+			PositiveCases: sdata.TotalPositiveCases(),
+			NegativeCases: sdata.TotalNegativeCases(),
+			SuspectCases:  sdata.TotalSuspectCases(),
+			Deaths:        sdata.TotalDeaths(),
+		})
+	}
+	return series
+}
+
+// ReadFile reads a single snapshot, either exported as JSON or as CSV.
+func ReadFile(path string) (*sinave.SinaveData, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if filepath.Ext(path) == ".csv" {
+		return DecodeCSV(data)
+	}
+	return sinave.DecodeSnapshot(data)
+}
+
+// DecodeCSV decodes the data as exported by `covid19mx -o csv`.
+func DecodeCSV(b []byte) (*sinave.SinaveData, error) {
+	r := csv.NewReader(bytes.NewReader(b))
+	r.TrimLeadingSpace = true
+	r.LazyQuotes = true
+	r.FieldsPerRecord = -1
+
+	sdata := &sinave.SinaveData{
+		States: make([]sinave.State, 0),
+	}
+	for line := 1; ; line++ {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if line == 1 {
+			// Skip the header.
+			continue
+		}
+		if len(record) < 5 {
+			return nil, fmt.Errorf("line %d: expected 5 columns, got %d", line, len(record))
+		}
+		var values [4]int
+		for i := range values {
+			v, err := strconv.Atoi(strings.TrimSpace(record[i+1]))
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %s", line, err)
+			}
+			values[i] = v
+		}
+		sdata.States = append(sdata.States, sinave.State{
+			Name:          strings.TrimSpace(record[0]),
+			PositiveCases: values[0],
+			NegativeCases: values[1],
+			SuspectCases:  values[2],
+			Deaths:        values[3],
+		})
+	}
+	return sdata, nil
+}
+
+func truncate(date time.Time) time.Time {
+	y, m, d := date.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
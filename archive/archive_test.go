@@ -0,0 +1,116 @@
+package archive
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestParseDate(t *testing.T) {
+	now := time.Date(2020, 6, 29, 21, 30, 0, 0, time.UTC)
+	for _, tt := range []struct {
+		in   string
+		want string
+	}{
+		{"2020-05-12", "2020-05-12"},
+		{"today", "2020-06-29"},
+		{"yesterday", "2020-06-28"},
+		{"2 days ago", "2020-06-27"},
+		{"7", "2020-06-22"},
+		{"7d", "2020-06-22"},
+		{"-7d", "2020-06-22"},
+		{"0", "2020-06-29"},
+	} {
+		got, err := ParseDate(tt.in, now)
+		if err != nil {
+			t.Errorf("%q: %s", tt.in, err)
+			continue
+		}
+		if got.Format(DateLayout) != tt.want {
+			t.Errorf("%q: got %s, want %s", tt.in, got.Format(DateLayout), tt.want)
+		}
+	}
+	for _, in := range []string{"", "tomorrow", "2020-13-01", "7w", "--7d"} {
+		if _, err := ParseDate(in, now); err == nil {
+			t.Errorf("%q: got no error", in)
+		}
+	}
+}
+
+func TestDecodeCSV(t *testing.T) {
+	sdata, err := DecodeCSV([]byte(`"Estado"               , "Casos Positivos" , "Casos Negativos" , "Casos Sospechosos" , "Decesos"
+  Aguascalientes       , 36              , 278             , 81                , 0
+  Baja California      , 35              , 248             , 174               , 1       , 9.5
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sdata.States) != 2 {
+		t.Fatalf("got %d states, want 2", len(sdata.States))
+	}
+	got := sdata.States[1]
+	if got.Name != "Baja California" || got.PositiveCases != 35 || got.NegativeCases != 248 ||
+		got.SuspectCases != 174 || got.Deaths != 1 {
+		t.Errorf("got %+v", got)
+	}
+
+	for name, in := range map[string]string{
+		"missing columns": "header\nAguascalientes, 1, 2, 3\n",
+		"not a number":    "header\nAguascalientes, 1, 2, tres, 4\n",
+	} {
+		if _, err := DecodeCSV([]byte(in)); err == nil {
+			t.Errorf("%s: got no error", name)
+		}
+	}
+}
+
+func TestOpen(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		// Snapshots from before the attack rates were kept.
+		"2020-03-30.json": `{"states": [{"name": "Aguascalientes", "positive": 24, "negative": 243, "suspect": 74, "deaths": 0}]}`,
+		"2020-03-31.csv":  "header\nAguascalientes, 36, 278, 81, 0\n",
+		"2020-04-01.csv":  "header\nAguascalientes, 1, 1, 1, 1\n",
+		"2020-04-01.json": `{"states": [{"name": "Aguascalientes", "positive": 40, "negative": 300, "suspect": 90, "deaths": 1, "attack_rate": 2.96},` +
+			`{"name": "Baja California", "positive": 50, "negative": 280, "suspect": 150, "deaths": 2, "attack_rate": 1.42}]}`,
+
+		// Files that are not state level snapshots.
+		"2020-04-01.meta.json":       `{"source": "sinave://"}`,
+		"2020-04-01.municipios.json": `{"municipios": {}}`,
+		"README.md":                  "# data",
+	}
+	for name, content := range files {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	store, err := Open(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := len(store.Dates()); got != 3 {
+		t.Fatalf("got %d dates, want 3", got)
+	}
+	series := store.StateSeries("Aguascalientes")
+	for i, want := range []int{24, 36, 40} {
+		if series[i].PositiveCases != want {
+			t.Errorf("%s: got %d positive cases, want %d", series[i].Date.Format(DateLayout), series[i].PositiveCases, want)
+		}
+	}
+	if series[2].AttackRate != 2.96 {
+		t.Errorf("got attack rate %.2f, want the one of the JSON file", series[2].AttackRate)
+	}
+
+	latest, date, err := store.Latest()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if date.Format(DateLayout) != "2020-04-01" || latest.TotalPositiveCases() != 90 {
+		t.Errorf("got %d positive cases on %s, want 90 on 2020-04-01", latest.TotalPositiveCases(), date.Format(DateLayout))
+	}
+	if got := store.States(); len(got) != 2 || got[0] != "Aguascalientes" || got[1] != "Baja California" {
+		t.Errorf("got states %v", got)
+	}
+}
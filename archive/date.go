@@ -0,0 +1,43 @@
+package archive
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ParseDate parses the date of a snapshot relative to the given day.
+// Supported formats are absolute dates (e.g. 2020-05-12), "today",
+// "yesterday", "2 days ago", or a number of days ago as in 7, 7d or -7d.
+func ParseDate(s string, now time.Time) (time.Time, error) {
+	now = truncate(now)
+	switch s {
+	case "today":
+		return now, nil
+	case "yesterday":
+		return now.AddDate(0, 0, -1), nil
+	case "2 days ago":
+		return now.AddDate(0, 0, -2), nil
+	}
+	if date, err := time.Parse(DateLayout, s); err == nil {
+		return date, nil
+	}
+	days, err := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(s, "-"), "d"))
+	if err != nil || days < 0 {
+		return time.Time{}, fmt.Errorf("Invalid date %q (e.g. 2020-05-12, yesterday, -7d)", s)
+	}
+	return now.AddDate(0, 0, -days), nil
+}
+
+// FileDate returns the date of a snapshot based on its file name
+// (e.g. data/2020-05-12.json).
+func FileDate(path string) (time.Time, bool) {
+	name := filepath.Base(path)
+	date, err := time.Parse(DateLayout, strings.TrimSuffix(name, filepath.Ext(name)))
+	if err != nil {
+		return time.Time{}, false
+	}
+	return date, true
+}
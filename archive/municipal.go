@@ -0,0 +1,61 @@
+package archive
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/wallyqs/covid19mx/sinave"
+)
+
+// MunicipalSuffix is the suffix of the files with the municipal level
+// data of a day, e.g. data/2020-05-12.municipios.json
+const MunicipalSuffix = ".municipios.json"
+
+// MunicipalPath returns the file with the municipal level data of a day.
+func MunicipalPath(dir string, date time.Time) string {
+	return filepath.Join(dir, date.Format(DateLayout)+MunicipalSuffix)
+}
+
+// WriteMunicipalSnapshot stores the municipal level data of a given day
+// into the archive directory, keyed by the INEGI code of the municipio,
+// along with its metadata (e.g. data/2020-05-12.municipios.meta.json).
+// Like with WriteSnapshot, writing the same data again only refreshes
+// the metadata.
+func WriteMunicipalSnapshot(dir string, date time.Time, muns map[string]sinave.Municipio, meta Metadata, opts SnapshotOptions) error {
+	data, err := EncodeMunicipios(muns)
+	if err != nil {
+		return err
+	}
+	return writeSnapshot(dir, MunicipalPath(dir, date), data, meta, opts)
+}
+
+// EncodeMunicipios encodes the municipal level data sorted by code.
+func EncodeMunicipios(muns map[string]sinave.Municipio) ([]byte, error) {
+	data, err := json.MarshalIndent(sinave.MunicipalSnapshot{Municipios: muns}, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(data, '\n'), nil
+}
+
+// ReadMunicipios reads the municipal level data of a day.
+func ReadMunicipios(path string) (map[string]sinave.Municipio, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return sinave.DecodeMunicipalSnapshot(data)
+}
+
+// Municipios returns the municipal level data of a day.
+func (s *Store) Municipios(date time.Time) (map[string]sinave.Municipio, error) {
+	muns, err := ReadMunicipios(MunicipalPath(s.dir, date))
+	if os.IsNotExist(err) {
+		return nil, fmt.Errorf("%s: %w", date.Format(DateLayout)+MunicipalSuffix, ErrSnapshotNotFound)
+	}
+	return muns, err
+}
@@ -0,0 +1,61 @@
+package archive
+
+import (
+	"errors"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/wallyqs/covid19mx/sinave"
+)
+
+func TestWriteMunicipalSnapshot(t *testing.T) {
+	dir := t.TempDir()
+	muns := map[string]sinave.Municipio{
+		"01001": {Name: "Aguascalientes", PositiveCases: 948, NegativeCases: 10, SuspectCases: 3, Deaths: 2},
+		"14039": {Name: "Guadalajara", PositiveCases: 8321},
+	}
+	fetchedAt := time.Date(2020, 5, 12, 19, 0, 0, 0, time.UTC)
+	meta := Metadata{Source: sinave.MunicipalURL, FetchedAt: fetchedAt}
+	if err := WriteMunicipalSnapshot(dir, testDate, muns, meta, SnapshotOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	got := readMetadata(t, filepath.Join(dir, "2020-05-12.municipios.meta.json"))
+	if got.Source != sinave.MunicipalURL || !got.FetchedAt.Equal(fetchedAt) || len(got.Checksum) != 64 {
+		t.Errorf("got metadata %+v", got)
+	}
+
+	store, err := Open(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(store.Dates()) != 0 {
+		t.Errorf("got dates %v, want the municipal files to be skipped", store.Dates())
+	}
+	read, err := store.Municipios(testDate)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(read, muns) {
+		t.Errorf("got %+v, want %+v", read, muns)
+	}
+	if _, err := store.Municipios(testDate.AddDate(0, 0, 1)); !errors.Is(err, ErrSnapshotNotFound) {
+		t.Errorf("got error %v, want %v", err, ErrSnapshotNotFound)
+	}
+
+	// Different data is only written when forced.
+	muns["14039"] = sinave.Municipio{Name: "Guadalajara", PositiveCases: 9000}
+	err = WriteMunicipalSnapshot(dir, testDate, muns, meta, SnapshotOptions{})
+	if !errors.Is(err, ErrSnapshotExists) {
+		t.Fatalf("got error %v, want %v", err, ErrSnapshotExists)
+	}
+	if err := WriteMunicipalSnapshot(dir, testDate, muns, meta, SnapshotOptions{Force: true}); err != nil {
+		t.Fatal(err)
+	}
+	forced := readMetadata(t, filepath.Join(dir, "2020-05-12.municipios.meta.json"))
+	if forced.Checksum == got.Checksum {
+		t.Error("got the same checksum for different data")
+	}
+}
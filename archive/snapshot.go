@@ -0,0 +1,137 @@
+package archive
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/wallyqs/covid19mx/sinave"
+)
+
+var (
+	ErrSnapshotExists = errors.New("Snapshot already exists with different data!")
+)
+
+// Metadata has the details about how a snapshot was fetched, it is
+// stored next to the snapshot (e.g. data/2020-05-12.meta.json).
+type Metadata struct {
+	Source    string    `json:"source"`
+	FetchedAt time.Time `json:"fetched_at"`
+	Checksum  string    `json:"sha256"`
+}
+
+// SnapshotOptions customizes how a snapshot is written.
+type SnapshotOptions struct {
+	// CSV also writes the snapshot in CSV format.
+	CSV bool
+
+	// Force overwrites a snapshot that has different data.
+	Force bool
+}
+
+// WriteSnapshot stores the data of a given day into the archive
+// directory along with its metadata. Files are replaced atomically, and
+// writing a snapshot with the same data as the existing one only
+// refreshes its metadata and CSV.
+func WriteSnapshot(dir string, date time.Time, sdata *sinave.SinaveData, meta Metadata, opts SnapshotOptions) error {
+	data, err := EncodeJSON(sdata)
+	if err != nil {
+		return err
+	}
+	name := date.Format(DateLayout)
+	if err := writeSnapshot(dir, filepath.Join(dir, name+".json"), data, meta, opts); err != nil {
+		return err
+	}
+	if opts.CSV {
+		var buf bytes.Buffer
+		EncodeCSV(&buf, sdata)
+		return writeFileAtomic(filepath.Join(dir, name+".csv"), buf.Bytes())
+	}
+	return nil
+}
+
+// metadataPath returns the file with the metadata of a snapshot.
+func metadataPath(path string) string {
+	return strings.TrimSuffix(path, ".json") + ".meta.json"
+}
+
+// writeSnapshot writes the encoded data of a snapshot unless the file
+// already has it, and then its metadata with the checksum of the data.
+func writeSnapshot(dir, path string, data []byte, meta Metadata, opts SnapshotOptions) error {
+	prev, err := ioutil.ReadFile(path)
+	unchanged := err == nil && bytes.Equal(prev, data)
+	switch {
+	case unchanged:
+	case err == nil && !opts.Force:
+		return fmt.Errorf("%s: %w", path, ErrSnapshotExists)
+	case err != nil && !os.IsNotExist(err):
+		return err
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	if !unchanged {
+		if err := writeFileAtomic(path, data); err != nil {
+			return err
+		}
+	}
+	sum := sha256.Sum256(data)
+	meta.Checksum = hex.EncodeToString(sum[:])
+	mdata, err := json.MarshalIndent(meta, "", "  ")
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(metadataPath(path), append(mdata, '\n'))
+}
+
+// EncodeJSON encodes the data in the same format as `covid19mx -o json`.
+func EncodeJSON(sdata *sinave.SinaveData) ([]byte, error) {
+	data, err := json.MarshalIndent(sdata, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(data, '\n'), nil
+}
+
+// EncodeCSV encodes the data in the same format as `covid19mx -o csv`.
+func EncodeCSV(buf *bytes.Buffer, sdata *sinave.SinaveData) {
+	fmt.Fprintln(buf, "\"Estado\"               , \"Casos Positivos\" , \"Casos Negativos\" , \"Casos Sospechosos\" , \"Decesos\"")
+	for _, state := range sdata.States {
+		if state.Name == "NACIONAL" {
+			continue
+		}
+		fmt.Fprintf(buf, "  %-20s , %-15d , %-15d , %-17d , %-7d \n",
+			state.Name, state.PositiveCases, state.NegativeCases, state.SuspectCases, state.Deaths)
+	}
+}
+
+func writeFileAtomic(path string, data []byte) error {
+	f, err := ioutil.TempFile(filepath.Dir(path), "."+filepath.Base(path)+".tmp")
+	if err != nil {
+		return err
+	}
+	tmp := f.Name()
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		os.Remove(tmp)
+		return err
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	if err := os.Chmod(tmp, 0644); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	return os.Rename(tmp, path)
+}
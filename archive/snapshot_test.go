@@ -0,0 +1,80 @@
+package archive
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/wallyqs/covid19mx/sinave"
+)
+
+var testDate = time.Date(2020, 5, 12, 0, 0, 0, 0, time.UTC)
+
+func testData(deaths int) *sinave.SinaveData {
+	return &sinave.SinaveData{States: []sinave.State{
+		{Name: "Aguascalientes", PositiveCases: 24, NegativeCases: 243, SuspectCases: 74, Deaths: deaths},
+	}}
+}
+
+func readMetadata(t *testing.T, path string) Metadata {
+	t.Helper()
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var meta Metadata
+	if err := json.Unmarshal(data, &meta); err != nil {
+		t.Fatal(err)
+	}
+	return meta
+}
+
+func TestWriteSnapshot(t *testing.T) {
+	dir := t.TempDir()
+	fetchedAt := time.Date(2020, 5, 12, 19, 0, 0, 0, time.UTC)
+	meta := Metadata{Source: "sinave://", FetchedAt: fetchedAt}
+	if err := WriteSnapshot(dir, testDate, testData(1), meta, SnapshotOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	got := readMetadata(t, filepath.Join(dir, "2020-05-12.meta.json"))
+	if got.Source != "sinave://" || !got.FetchedAt.Equal(fetchedAt) || len(got.Checksum) != 64 {
+		t.Errorf("got metadata %+v", got)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "2020-05-12.csv")); !os.IsNotExist(err) {
+		t.Errorf("got a CSV file without asking for it (%v)", err)
+	}
+
+	// The same data again writes the CSV and refreshes the metadata.
+	meta.FetchedAt = fetchedAt.Add(time.Hour)
+	if err := WriteSnapshot(dir, testDate, testData(1), meta, SnapshotOptions{CSV: true}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "2020-05-12.csv")); err != nil {
+		t.Errorf("the CSV file was not written: %v", err)
+	}
+	refreshed := readMetadata(t, filepath.Join(dir, "2020-05-12.meta.json"))
+	if !refreshed.FetchedAt.Equal(meta.FetchedAt) || refreshed.Checksum != got.Checksum {
+		t.Errorf("got metadata %+v, want it refreshed with the same checksum", refreshed)
+	}
+
+	// Different data is only written when forced.
+	err := WriteSnapshot(dir, testDate, testData(2), meta, SnapshotOptions{})
+	if !errors.Is(err, ErrSnapshotExists) {
+		t.Fatalf("got error %v, want %v", err, ErrSnapshotExists)
+	}
+	if err := WriteSnapshot(dir, testDate, testData(2), meta, SnapshotOptions{Force: true}); err != nil {
+		t.Fatal(err)
+	}
+	sdata, err := ReadFile(filepath.Join(dir, "2020-05-12.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sdata.States[0].Deaths != 2 {
+		t.Errorf("got %d deaths, want the forced snapshot with 2", sdata.States[0].Deaths)
+	}
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/wallyqs/covid19mx/sinave"
+)
+
+// runCache lists or clears the cached responses from the data sources.
+func runCache(args []string) error {
+	fs := flag.NewFlagSet("cache", flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Printf("Usage: covid19mx cache <ls|clear>\n\n")
+		fmt.Printf("  ls\tList the cached responses\n")
+		fmt.Printf("  clear\tRemove every cached response\n")
+		fmt.Println()
+	}
+	fs.Parse(args)
+
+	dir, err := sinave.DefaultCacheDir()
+	if err != nil {
+		return err
+	}
+	cache := &sinave.Cache{Dir: dir}
+
+	switch fs.Arg(0) {
+	case "ls":
+		entries, err := cache.Entries()
+		if err != nil {
+			return err
+		}
+		tw := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+		fmt.Fprintf(tw, "Age\tSize\tMethod\tURL\tRequest\n")
+		for _, e := range entries {
+			age := time.Since(e.FetchedAt).Round(time.Second)
+			fmt.Fprintf(tw, "%s\t%d\t%s\t%s\t%s\n", age, len(e.Body), e.Method, e.URL, e.Request)
+		}
+		return tw.Flush()
+	case "clear":
+		n, err := cache.Clear()
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Removed %d cached responses from %s\n", n, dir)
+	default:
+		fs.Usage()
+		os.Exit(1)
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/wallyqs/covid19mx/sinave"
+)
+
+// withCacheHome points the cache to a temporary directory and restores
+// the default client after the test.
+func withCacheHome(t *testing.T) string {
+	t.Helper()
+	home := t.TempDir()
+	t.Setenv("XDG_CACHE_HOME", home)
+	prev := sinave.DefaultClient
+	t.Cleanup(func() { sinave.DefaultClient = prev })
+	return filepath.Join(home, "covid19mx")
+}
+
+// captureStdout returns what f writes to the standard output.
+func captureStdout(t *testing.T, f func() error) string {
+	t.Helper()
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	stdout := os.Stdout
+	os.Stdout = w
+	err = f()
+	os.Stdout = stdout
+	w.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := ioutil.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(out)
+}
+
+func TestClientFlagsCache(t *testing.T) {
+	for _, tt := range []struct {
+		args  []string
+		cache bool
+	}{
+		{nil, true},
+		{[]string{"-no-cache"}, false},
+		{[]string{"-cache-ttl", "0"}, false},
+		{[]string{"-record", "recording"}, false},
+		{[]string{"-replay", "recording"}, false},
+	} {
+		dir := withCacheHome(t)
+		fs := flag.NewFlagSet("test", flag.ContinueOnError)
+		setupClient := clientFlags(fs)
+		if err := fs.Parse(tt.args); err != nil {
+			t.Fatal(err)
+		}
+		setupClient()
+
+		cache := sinave.DefaultClient.Cache
+		switch {
+		case tt.cache && (cache == nil || cache.Dir != dir):
+			t.Errorf("%v: got cache %+v, want one in %s", tt.args, cache, dir)
+		case !tt.cache && cache != nil:
+			t.Errorf("%v: got cache %+v, want none", tt.args, cache)
+		}
+	}
+}
+
+func TestCacheCommand(t *testing.T) {
+	dir := withCacheHome(t)
+	cache := &sinave.Cache{Dir: dir, TTL: time.Hour}
+	for _, caseType := range []string{"Confirmados", "Negativos"} {
+		if err := cache.Put("POST", sinave.MunicipalURL, []byte("sPatType="+caseType), []byte("var m;")); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	out := captureStdout(t, func() error { return runCache([]string{"ls"}) })
+	for _, want := range []string{sinave.MunicipalURL, "sPatType=Confirmados", "sPatType=Negativos"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("got listing:\n%s\nwant it to contain %q", out, want)
+		}
+	}
+
+	out = captureStdout(t, func() error { return runCache([]string{"clear"}) })
+	if !strings.HasPrefix(out, "Removed 2 cached responses") {
+		t.Errorf("got %q, want 2 responses removed", out)
+	}
+	if entries, err := cache.Entries(); err != nil || len(entries) != 0 {
+		t.Errorf("got entries %+v (%v) after clearing the cache", entries, err)
+	}
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/wallyqs/covid19mx/geo"
+)
+
+// runCatalog compares the embedded catalog of municipios with a catalog
+// from INEGI, e.g. the AGEEML catalog in CSV format.
+func runCatalog(args []string) error {
+	fs := flag.NewFlagSet("catalog", flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Printf("Usage: covid19mx catalog check <file.csv>\n\n")
+		fmt.Printf("The file needs the CVEGEO or CVE_ENT and CVE_MUN columns, and the NOM_MUN column.\n\n")
+	}
+	fs.Parse(args)
+	if fs.Arg(0) != "check" || fs.NArg() != 2 {
+		fs.Usage()
+		os.Exit(1)
+	}
+
+	catalog, err := geo.ReadCatalog(fs.Arg(1))
+	if err != nil {
+		return err
+	}
+	diff := geo.CompareCatalog(catalog.Municipios)
+	fmt.Printf("%d municipios embedded, %d in %s\n", len(geo.MunicipiosMexico), len(catalog.Municipios), fs.Arg(1))
+	if diff.Empty() {
+		fmt.Println("No differences found.")
+		return nil
+	}
+
+	if len(diff.Missing) > 0 {
+		fmt.Printf("\nMissing from the embedded catalog (%d):\n", len(diff.Missing))
+		for _, code := range diff.Missing {
+			fmt.Printf("  %s  %s\n", code, catalog.Municipios[code].Name)
+		}
+	}
+	if len(diff.Extra) > 0 {
+		fmt.Printf("\nNot in %s (%d):\n", fs.Arg(1), len(diff.Extra))
+		for _, code := range diff.Extra {
+			fmt.Printf("  %s  %s\n", code, geo.MunicipiosMexico[code].Name)
+		}
+	}
+	if len(diff.Renamed) > 0 {
+		fmt.Printf("\nDifferent names (%d):\n", len(diff.Renamed))
+		for _, code := range diff.Renamed {
+			fmt.Printf("  %s  %q -> %q\n", code, geo.MunicipiosMexico[code].Name, catalog.Municipios[code].Name)
+		}
+	}
+	return errors.New("The embedded catalog differs from the INEGI catalog")
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/wallyqs/covid19mx/archive"
+	"github.com/wallyqs/covid19mx/report"
+	"github.com/wallyqs/covid19mx/sinave"
+	"github.com/wallyqs/covid19mx/source"
+)
+
+// runDiff compares the data between two sources.
+func runDiff(args []string) error {
+	var (
+		exportFormat string
+		archiveDir   string
+	)
+	fs := flag.NewFlagSet("diff", flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Printf("Usage: covid19mx diff [options...] <from> <to>\n\n")
+		fmt.Printf("Sources can be files (data/2020-05-01.json), dates (2020-05-01, -7d),\n")
+		fmt.Printf("urls, source URIs (archive://data) or 'live' for the latest data from SINAVE.\n\n")
+		fs.PrintDefaults()
+		fmt.Println()
+	}
+	fs.StringVar(&exportFormat, "o", "", "Export format (options: json, csv, table, awk)")
+	fs.StringVar(&archiveDir, "archive", "", "Directory with the snapshots to use instead of the repo mirror")
+	setupClient := clientFlags(fs)
+	parseOrder := sortFlags(fs)
+	fs.Parse(args)
+	setupClient()
+	order, err := parseOrder()
+	if err != nil {
+		return err
+	}
+
+	if fs.NArg() != 2 {
+		fs.Usage()
+		return errors.New("Expected two sources to compare")
+	}
+	pdata, err := loadSource(fs.Arg(0), archiveDir)
+	if err != nil {
+		return err
+	}
+	sdata, err := loadSource(fs.Arg(1), archiveDir)
+	if err != nil {
+		return err
+	}
+	return showDiff(exportFormat, order.States(sdata), pdata)
+}
+
+// loadSource gets the data from a source URI, a local file, an url,
+// or from the snapshot of a given date.
+func loadSource(uri, archiveDir string) (*sinave.SinaveData, error) {
+	if uri == "live" {
+		uri = "sinave://"
+	}
+	src, err := source.Parse(uri)
+	if err == nil {
+		return src.Fetch(context.Background(), time.Time{})
+	}
+
+	date, err := archive.ParseDate(uri, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("Unknown source %q", uri)
+	}
+	return loadPastData(&CliConfig{archive: archiveDir}, date)
+}
+
+// showDiff displays the difference between two days in the given format.
+func showDiff(exportFormat string, sdata, pdata *sinave.SinaveData) error {
+	switch exportFormat {
+	case "csv":
+		report.CSVDiff(os.Stdout, sdata, pdata)
+	case "json":
+		return report.JSONDiff(os.Stdout, sdata, pdata)
+	case "awk":
+		report.AwkFriendlyDiff(os.Stdout, sdata, pdata)
+	default:
+		report.TableDiff(os.Stdout, sdata, pdata)
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/wallyqs/covid19mx/sinave"
+)
+
+// runDoctor probes the upstream endpoints and fails when they changed in
+// a way that could break fetching the data.
+func runDoctor(args []string) error {
+	fs := flag.NewFlagSet("doctor", flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Printf("Usage: covid19mx doctor [options...]\n\n")
+		fs.PrintDefaults()
+		fmt.Println()
+	}
+	setupClient := clientFlags(fs)
+	fs.Parse(args)
+	setupClient()
+
+	// Always check the live endpoints.
+	sinave.DefaultClient.Cache = nil
+
+	checkup := sinave.Diagnose(context.Background())
+	fmt.Printf("%-56s %-6s %-5s %s\n", "Endpoint", "Status", "Rows", "Columns or endpoints")
+	for _, p := range checkup.Probes {
+		status := "-"
+		if p.StatusCode != 0 {
+			status = strconv.Itoa(p.StatusCode)
+		}
+		rows := "-"
+		if p.Rows > 0 {
+			rows = strconv.Itoa(p.Rows)
+		}
+		columns := make([]string, 0)
+		for _, c := range p.Columns {
+			columns = append(columns, strconv.Itoa(c))
+		}
+		columns = append(columns, p.Graficas...)
+		fmt.Printf("%-56s %-6s %-5s %s\n", p.URL, status, rows, strings.Join(columns, ", "))
+	}
+	fmt.Println()
+
+	if len(checkup.Drift) == 0 {
+		fmt.Println("No drift detected.")
+		return nil
+	}
+	fmt.Println("Drift detected:")
+	for _, d := range checkup.Drift {
+		fmt.Printf("  - %s\n", d)
+	}
+	return errors.New("Detected drift in the upstream endpoints")
+}
@@ -0,0 +1,236 @@
+package geo
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/csv"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	// UnassignedMunicipio is the code used by INEGI for the municipios
+	// that are not specified, the municipios with codes missing from
+	// MunicipiosMexico are assigned to it within their state.
+	UnassignedMunicipio = "999"
+	UnassignedName      = "No especificado"
+
+	// ForeignState is the code of the bucket for the codes that do not
+	// belong to any state, e.g. cases from abroad.
+	ForeignState = "99"
+	ForeignName  = "Extranjero"
+)
+
+// StateName returns the name of a state given its code, including the
+// bucket for foreign codes.
+func StateName(code string) string {
+	if code == ForeignState {
+		return ForeignName
+	}
+	return StatesMap[code]
+}
+
+// MunicipioName returns the name of a municipio given its code,
+// including the buckets for the unknown codes.
+func MunicipioName(code string) string {
+	if m, ok := MunicipiosMexico[code]; ok {
+		return m.Name
+	}
+	if code == ForeignState+UnassignedMunicipio {
+		return ForeignName
+	}
+	if len(code) == 5 && strings.HasSuffix(code, UnassignedMunicipio) {
+		return UnassignedName
+	}
+	return ""
+}
+
+// Bucket returns the code under which the data of a municipio is shown,
+// which is the code itself when it is in MunicipiosMexico, the
+// unassigned municipio of its state when only the state is known, or
+// the foreign bucket otherwise.
+func Bucket(code string) string {
+	if _, ok := MunicipiosMexico[code]; ok {
+		return code
+	}
+	if len(code) == 5 {
+		if _, ok := StatesMap[code[:2]]; ok {
+			return code[:2] + UnassignedMunicipio
+		}
+	}
+	return ForeignState + UnassignedMunicipio
+}
+
+//go:embed municipios.csv
+var embeddedCatalog []byte
+
+func init() {
+	c, err := ParseCatalog(embeddedCatalog)
+	if err != nil {
+		panic(fmt.Sprintf("geo: embedded catalog: %s", err))
+	}
+	StatesMap = c.States
+	MunicipiosMexico = c.Municipios
+}
+
+// Catalog has the names of the states and the municipios keyed by their
+// INEGI codes.
+type Catalog struct {
+	States     map[string]string
+	Municipios map[string]MunicipioDetail
+}
+
+// ReadCatalog reads a catalog from a CSV file, see ParseCatalog.
+func ReadCatalog(path string) (*Catalog, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	c, err := ParseCatalog(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", path, err)
+	}
+	return c, nil
+}
+
+// LoadCatalog replaces the municipios of the embedded catalog with the
+// ones from a CSV file. The names of the states are kept since they are
+// the ones used by SINAVE to match the state level data, the official
+// names from the file (e.g. "Coahuila de Zaragoza") are added to
+// StateAliases instead.
+func LoadCatalog(path string) error {
+	c, err := ReadCatalog(path)
+	if err != nil {
+		return err
+	}
+	for code, name := range c.States {
+		current, ok := StatesMap[code]
+		switch {
+		case !ok:
+			StatesMap[code] = name
+		case Normalize(current) != Normalize(name):
+			StateAliases[Normalize(name)] = code
+		}
+	}
+	MunicipiosMexico = c.Municipios
+	return nil
+}
+
+// ParseCatalog reads the municipios from a catalog of INEGI in CSV
+// format, e.g. the AGEEML catalog of municipios. The columns are found by
+// their header, either CVEGEO or CVE_ENT and CVE_MUN for the code,
+// NOM_MUN for the name and optionally NOM_ENT for the name of the state.
+// Files in Latin-1 are converted to UTF-8.
+func ParseCatalog(data []byte) (*Catalog, error) {
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+	if !utf8.Valid(data) {
+		data = latin1ToUTF8(data)
+	}
+
+	r := csv.NewReader(bytes.NewReader(data))
+	r.FieldsPerRecord = -1
+	r.LazyQuotes = true
+	records, err := r.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("empty catalog")
+	}
+
+	cols := make(map[string]int)
+	for i, h := range records[0] {
+		cols[strings.ToUpper(strings.TrimSpace(h))] = i
+	}
+	geoCol, hasGeo := cols["CVEGEO"]
+	entCol, hasEnt := cols["CVE_ENT"]
+	munCol, hasMun := cols["CVE_MUN"]
+	nameCol, hasName := cols["NOM_MUN"]
+	stateCol, hasState := cols["NOM_ENT"]
+	if !hasName || !(hasGeo || (hasEnt && hasMun)) {
+		return nil, fmt.Errorf("expected the CVEGEO or CVE_ENT and CVE_MUN columns, and the NOM_MUN column")
+	}
+
+	c := &Catalog{
+		States:     make(map[string]string),
+		Municipios: make(map[string]MunicipioDetail),
+	}
+	for i, rec := range records[1:] {
+		field := func(col int) string {
+			if col >= len(rec) {
+				return ""
+			}
+			return strings.TrimSpace(rec[col])
+		}
+		var code string
+		if hasGeo && len(field(geoCol)) == 5 {
+			code = field(geoCol)
+		} else if hasEnt && hasMun {
+			code = fmt.Sprintf("%02s%03s", field(entCol), field(munCol))
+		}
+		if len(code) != 5 {
+			return nil, fmt.Errorf("line %d: invalid municipio code %q", i+2, code)
+		}
+		c.Municipios[code] = MunicipioDetail{EstadoGeo: code[:2], Name: field(nameCol)}
+		if hasState && field(stateCol) != "" {
+			c.States[code[:2]] = field(stateCol)
+		}
+	}
+	return c, nil
+}
+
+// CatalogDiff has the differences between MunicipiosMexico and a
+// catalog, each list is sorted by code.
+type CatalogDiff struct {
+	// Missing are the codes in the catalog that are not embedded.
+	Missing []string
+
+	// Extra are the embedded codes that are not in the catalog.
+	Extra []string
+
+	// Renamed are the codes with a different name in the catalog.
+	Renamed []string
+}
+
+// Empty reports whether there are no differences.
+func (d *CatalogDiff) Empty() bool {
+	return len(d.Missing) == 0 && len(d.Extra) == 0 && len(d.Renamed) == 0
+}
+
+// CompareCatalog compares MunicipiosMexico with a catalog.
+func CompareCatalog(catalog map[string]MunicipioDetail) *CatalogDiff {
+	diff := &CatalogDiff{
+		Missing: make([]string, 0),
+		Extra:   make([]string, 0),
+		Renamed: make([]string, 0),
+	}
+	for code, m := range catalog {
+		embedded, ok := MunicipiosMexico[code]
+		switch {
+		case !ok:
+			diff.Missing = append(diff.Missing, code)
+		case embedded.Name != m.Name:
+			diff.Renamed = append(diff.Renamed, code)
+		}
+	}
+	for code := range MunicipiosMexico {
+		if _, ok := catalog[code]; !ok {
+			diff.Extra = append(diff.Extra, code)
+		}
+	}
+	sort.Strings(diff.Missing)
+	sort.Strings(diff.Extra)
+	sort.Strings(diff.Renamed)
+	return diff
+}
+
+func latin1ToUTF8(b []byte) []byte {
+	runes := make([]rune, len(b))
+	for i, c := range b {
+		runes[i] = rune(c)
+	}
+	return []byte(string(runes))
+}
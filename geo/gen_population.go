@@ -0,0 +1,62 @@
+//go:build ignore
+// +build ignore
+
+// gen_population writes poblacion.csv from the ITER results of the 2020
+// census (ITER_NALCSV20.csv), which can be downloaded from
+// https://www.inegi.org.mx/programas/ccpv/2020/#Datos_abiertos
+//
+//	go run gen_population.go ITER_NALCSV20.csv poblacion.csv
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"sort"
+
+	"github.com/wallyqs/covid19mx/geo"
+)
+
+func main() {
+	log.SetFlags(0)
+	if len(os.Args) != 3 {
+		log.Fatal("Usage: go run gen_population.go ITER_NALCSV20.csv poblacion.csv")
+	}
+	data, err := ioutil.ReadFile(os.Args[1])
+	if err != nil {
+		log.Fatal(err)
+	}
+	p, err := geo.ParsePopulation(data)
+	if err != nil {
+		log.Fatalf("%s: %s", os.Args[1], err)
+	}
+
+	codes := make([]string, 0, len(p))
+	for code := range p {
+		if len(code) == 5 {
+			codes = append(codes, code)
+		}
+	}
+	sort.Strings(codes)
+	missing := make([]string, 0)
+	for code := range geo.MunicipiosMexico {
+		if _, ok := p[code]; !ok {
+			missing = append(missing, code)
+		}
+	}
+	sort.Strings(missing)
+	for _, code := range missing {
+		log.Printf("Warning: no population for %s %s", code, geo.MunicipiosMexico[code].Name)
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintln(&buf, "CVEGEO,POBTOT")
+	for _, code := range codes {
+		fmt.Fprintf(&buf, "%s,%d\n", code, p[code])
+	}
+	if err := ioutil.WriteFile(os.Args[2], buf.Bytes(), 0644); err != nil {
+		log.Fatal(err)
+	}
+}
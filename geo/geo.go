@@ -0,0 +1,20 @@
+// Package geo holds the geographic catalog used to label the data
+// published by the Mexican government: the 32 states and their
+// municipios keyed by their INEGI codes. The catalog is embedded from
+// municipios.csv and can be replaced with LoadCatalog.
+package geo
+
+// MunicipioDetail has the state to which a municipio belongs to and
+// its name.
+type MunicipioDetail struct {
+	EstadoGeo string
+	Name      string
+}
+
+var (
+	// StatesMap maps the id of a state to its name.
+	StatesMap map[string]string
+
+	// MunicipiosMexico maps the 5 digit INEGI code of a municipio to its details.
+	MunicipiosMexico map[string]MunicipioDetail
+)
@@ -0,0 +1,238 @@
+package geo
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// LookupMunicipio returns the details of a municipio given its 5 digit
+// INEGI code.
+func LookupMunicipio(code string) (MunicipioDetail, bool) {
+	m, ok := MunicipiosMexico[code]
+	return m, ok
+}
+
+// MunicipiosByName returns the codes of the municipios with the given
+// name ignoring case, sorted by code.
+func MunicipiosByName(name string) []string {
+	codes := make([]string, 0)
+	for code, m := range MunicipiosMexico {
+		if strings.EqualFold(m.Name, strings.TrimSpace(name)) {
+			codes = append(codes, code)
+		}
+	}
+	sort.Strings(codes)
+	return codes
+}
+
+// MunicipiosByFuzzyName returns the codes of the municipios whose name
+// matches ignoring case, accents and punctuation, sorted by code. When no
+// name is equal, the names that start with or contain the given one are
+// returned instead.
+func MunicipiosByFuzzyName(name string) []string {
+	names := make(map[string]string, len(MunicipiosMexico))
+	for code, m := range MunicipiosMexico {
+		names[code] = m.Name
+	}
+	return fuzzyMatch(names, name)
+}
+
+// StatesByFuzzyName returns the codes of the states whose name matches
+// like with MunicipiosByFuzzyName.
+func StatesByFuzzyName(name string) []string {
+	return fuzzyMatch(StatesMap, name)
+}
+
+// Normalize folds a name for comparisons: lower case, without accents
+// and with any punctuation and repeated spaces collapsed into a single
+// space, e.g. "Juárez" and "JUAREZ" are both "juarez".
+func Normalize(s string) string {
+	var sb strings.Builder
+	space := false
+	for _, r := range strings.ToLower(s) {
+		if f, ok := accents[r]; ok {
+			r = f
+		}
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			space = sb.Len() > 0
+			continue
+		}
+		if space {
+			sb.WriteByte(' ')
+			space = false
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
+
+var accents = map[rune]rune{
+	'á': 'a', 'à': 'a', 'ä': 'a', 'â': 'a',
+	'é': 'e', 'è': 'e', 'ë': 'e', 'ê': 'e',
+	'í': 'i', 'ì': 'i', 'ï': 'i', 'î': 'i',
+	'ó': 'o', 'ò': 'o', 'ö': 'o', 'ô': 'o',
+	'ú': 'u', 'ù': 'u', 'ü': 'u', 'û': 'u',
+	'ñ': 'n',
+}
+
+// fuzzyMatch returns the codes of the names equal to the given one once
+// normalized, or else the ones that start with it, or else the ones that
+// contain it.
+func fuzzyMatch(names map[string]string, name string) []string {
+	want := Normalize(name)
+	var equal, prefix, contains []string
+	if want == "" {
+		return []string{}
+	}
+	for code, n := range names {
+		got := Normalize(n)
+		switch {
+		case got == want:
+			equal = append(equal, code)
+		case strings.HasPrefix(got, want):
+			prefix = append(prefix, code)
+		case strings.Contains(got, want):
+			contains = append(contains, code)
+		}
+	}
+	for _, codes := range [][]string{equal, prefix, contains} {
+		if len(codes) > 0 {
+			sort.Strings(codes)
+			return codes
+		}
+	}
+	return []string{}
+}
+
+// ErrNotFound is returned when a name does not match any state or
+// municipio.
+var ErrNotFound = errors.New("not found")
+
+// AmbiguousError is returned when a name matches more than one state or
+// municipio, the suggestions can be used as they are to pick one.
+type AmbiguousError struct {
+	Query       string
+	Suggestions []string
+}
+
+func (e *AmbiguousError) Error() string {
+	return fmt.Sprintf("%q is ambiguous, did you mean: %s", e.Query, strings.Join(e.Suggestions, ", "))
+}
+
+// StateAliases are other common names of the states, normalized.
+var StateAliases = map[string]string{
+	"cdmx":                            "09",
+	"df":                              "09",
+	"distrito federal":                "09",
+	"edomex":                          "15",
+	"estado de mexico":                "15",
+	"nl":                              "19",
+	"slp":                             "24",
+	"qroo":                            "23",
+	"bc":                              "02",
+	"bcs":                             "03",
+	"coahuila de zaragoza":            "05",
+	"michoacan de ocampo":             "16",
+	"veracruz de ignacio de la llave": "30",
+	"queretaro de arteaga":            "22",
+}
+
+// ResolveStates returns the codes of the states from a comma separated
+// list of names, aliases or 2 digit codes.
+func ResolveStates(query string) ([]string, error) {
+	codes := make([]string, 0)
+	for _, q := range splitList(query) {
+		if _, ok := StatesMap[q]; ok {
+			codes = append(codes, q)
+			continue
+		}
+		if code, ok := StateAliases[Normalize(q)]; ok {
+			codes = append(codes, code)
+			continue
+		}
+		matches := StatesByFuzzyName(q)
+		switch len(matches) {
+		case 0:
+			return nil, fmt.Errorf("State %q %w", q, ErrNotFound)
+		case 1:
+			codes = append(codes, matches[0])
+		default:
+			suggestions := make([]string, 0, len(matches))
+			for _, code := range matches {
+				suggestions = append(suggestions, strconv.Quote(StatesMap[code]))
+			}
+			return nil, &AmbiguousError{q, suggestions}
+		}
+	}
+	return codes, nil
+}
+
+// ResolveMunicipios returns the codes of the municipios from a comma
+// separated list of names or 5 digit codes, only looking into the given
+// states unless there are none. A name can be qualified with its state,
+// e.g. "Juárez (Chihuahua)", which is also how ambiguous names are
+// suggested.
+func ResolveMunicipios(query string, states []string) ([]string, error) {
+	codes := make([]string, 0)
+	for _, q := range splitList(query) {
+		if _, ok := MunicipiosMexico[q]; ok {
+			codes = append(codes, q)
+			continue
+		}
+
+		within := states
+		name := q
+		if i := strings.LastIndex(q, "("); i > 0 && strings.HasSuffix(q, ")") {
+			var err error
+			within, err = ResolveStates(q[i+1 : len(q)-1])
+			if err != nil {
+				return nil, err
+			}
+			name = strings.TrimSpace(q[:i])
+		}
+
+		matches := make([]string, 0)
+		for _, code := range MunicipiosByFuzzyName(name) {
+			if len(within) == 0 || containsCode(within, code[:2]) {
+				matches = append(matches, code)
+			}
+		}
+		switch len(matches) {
+		case 0:
+			return nil, fmt.Errorf("Municipio %q %w", q, ErrNotFound)
+		case 1:
+			codes = append(codes, matches[0])
+		default:
+			suggestions := make([]string, 0, len(matches))
+			for _, code := range matches {
+				suggestions = append(suggestions, strconv.Quote(fmt.Sprintf("%s (%s)", MunicipiosMexico[code].Name, StatesMap[code[:2]])))
+			}
+			return nil, &AmbiguousError{q, suggestions}
+		}
+	}
+	return codes, nil
+}
+
+// splitList splits a comma separated list ignoring the empty items.
+func splitList(s string) []string {
+	items := make([]string, 0)
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+func containsCode(codes []string, code string) bool {
+	for _, c := range codes {
+		if c == code {
+			return true
+		}
+	}
+	return false
+}
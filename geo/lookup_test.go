@@ -0,0 +1,134 @@
+package geo
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestNormalize(t *testing.T) {
+	for in, want := range map[string]string{
+		"Juárez":              "juarez",
+		"JUAREZ":              "juarez",
+		"  Nuevo   León ":     "nuevo leon",
+		"San Luis Potosí":     "san luis potosi",
+		"Juárez (Chihuahua)":  "juarez chihuahua",
+		"Dolores Hidalgo C.I": "dolores hidalgo c i",
+		"":                    "",
+	} {
+		if got := Normalize(in); got != want {
+			t.Errorf("Normalize(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestResolveStates(t *testing.T) {
+	for _, tt := range []struct {
+		query string
+		want  []string
+	}{
+		{"Jalisco", []string{"14"}},
+		{"jalisco", []string{"14"}},
+		{"14", []string{"14"}},
+		{"nuevo leon", []string{"19"}},
+		{"cdmx", []string{"09"}},
+		{"CDMX", []string{"09"}},
+		{"edomex", []string{"15"}},
+		{"Estado de México", []string{"15"}},
+		{"mexico", []string{"15"}},
+		{"Baja California", []string{"02"}},
+		{"bcs", []string{"03"}},
+		{"yuca", []string{"31"}},
+		{"Jalisco, cdmx,,", []string{"14", "09"}},
+	} {
+		got, err := ResolveStates(tt.query)
+		if err != nil {
+			t.Errorf("%q: %s", tt.query, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%q: got %v, want %v", tt.query, got, tt.want)
+		}
+	}
+}
+
+func TestResolveStatesErrors(t *testing.T) {
+	_, err := ResolveStates("baja")
+	var aerr *AmbiguousError
+	if !errors.As(err, &aerr) {
+		t.Fatalf("got error %v, want an *AmbiguousError", err)
+	}
+	want := []string{`"Baja California"`, `"Baja California Sur"`}
+	if aerr.Query != "baja" || !reflect.DeepEqual(aerr.Suggestions, want) {
+		t.Errorf("got %q with suggestions %v, want %v", aerr.Query, aerr.Suggestions, want)
+	}
+
+	for _, query := range []string{"Texas", "33", "jalisco,narnia"} {
+		if _, err := ResolveStates(query); !errors.Is(err, ErrNotFound) {
+			t.Errorf("%q: got error %v, want %v", query, err, ErrNotFound)
+		}
+	}
+}
+
+func TestResolveMunicipios(t *testing.T) {
+	for _, tt := range []struct {
+		query  string
+		states []string
+		want   []string
+	}{
+		{"Guadalajara", nil, []string{"14039"}},
+		{"guadalajara, ZAPOPAN", nil, []string{"14039", "14120"}},
+		{"14039", nil, []string{"14039"}},
+		{"Juárez (Chihuahua)", nil, []string{"08037"}},
+		{"juarez (nl)", nil, []string{"19031"}},
+		{"Juarez", []string{"08"}, []string{"08037"}},
+		{"Benito Juárez (cdmx)", nil, []string{"09014"}},
+	} {
+		got, err := ResolveMunicipios(tt.query, tt.states)
+		if err != nil {
+			t.Errorf("%q: %s", tt.query, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%q: got %v, want %v", tt.query, got, tt.want)
+		}
+	}
+}
+
+func TestResolveMunicipiosErrors(t *testing.T) {
+	_, err := ResolveMunicipios("Juárez", nil)
+	var aerr *AmbiguousError
+	if !errors.As(err, &aerr) {
+		t.Fatalf("got error %v, want an *AmbiguousError", err)
+	}
+	want := []string{
+		`"Juárez (Coahuila)"`,
+		`"Juárez (Chiapas)"`,
+		`"Juárez (Chihuahua)"`,
+		`"Juárez (Michoacán)"`,
+		`"Juárez (Nuevo León)"`,
+	}
+	if !reflect.DeepEqual(aerr.Suggestions, want) {
+		t.Errorf("got suggestions %v, want %v", aerr.Suggestions, want)
+	}
+
+	// The suggestions can be used as they are.
+	for _, s := range aerr.Suggestions {
+		if _, err := ResolveMunicipios(s[1:len(s)-1], nil); err != nil {
+			t.Errorf("%s: %s", s, err)
+		}
+	}
+
+	for _, tt := range []struct {
+		query  string
+		states []string
+	}{
+		{"Springfield", nil},
+		{"Guadalajara", []string{"09"}},
+		{"Juárez (Texas)", nil},
+	} {
+		if _, err := ResolveMunicipios(tt.query, tt.states); !errors.Is(err, ErrNotFound) {
+			t.Errorf("%q: got error %v, want %v", tt.query, err, ErrNotFound)
+		}
+	}
+}
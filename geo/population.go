@@ -0,0 +1,209 @@
+package geo
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/csv"
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// PopulationByState maps the id of a state to its population according to
+// the Censo de Población y Vivienda 2020 from INEGI.
+var PopulationByState = map[string]int{
+	"01": 1425607,
+	"02": 3769020,
+	"03": 798447,
+	"04": 928363,
+	"05": 3146771,
+	"06": 731391,
+	"07": 5543828,
+	"08": 3741869,
+	"09": 9209944,
+	"10": 1832650,
+	"11": 6166934,
+	"12": 3540685,
+	"13": 3082841,
+	"14": 8348151,
+	"15": 16992418,
+	"16": 4748846,
+	"17": 1971520,
+	"18": 1235456,
+	"19": 5784442,
+	"20": 4132148,
+	"21": 6583278,
+	"22": 2368467,
+	"23": 1857985,
+	"24": 2822255,
+	"25": 3026943,
+	"26": 2944840,
+	"27": 2402598,
+	"28": 3527735,
+	"29": 1342977,
+	"30": 8062579,
+	"31": 2320898,
+	"32": 1622138,
+}
+
+// PopulationByMunicipio maps the 5 digit INEGI code of a municipio to its
+// population, it is read from the embedded poblacion.csv and can be
+// replaced with LoadPopulation.
+var PopulationByMunicipio = map[string]int{}
+
+// embeddedPopulation has the population of the municipios generated from
+// the ITER results of the 2020 census, see gen_population.go.
+//
+//go:generate go run gen_population.go ITER_NALCSV20.csv poblacion.csv
+//go:embed poblacion.csv
+var embeddedPopulation []byte
+
+func init() {
+	p, err := ParsePopulation(embeddedPopulation)
+	if err != nil {
+		panic(fmt.Sprintf("geo: embedded population: %s", err))
+	}
+	for code, pop := range p {
+		if len(code) == 5 {
+			PopulationByMunicipio[code] = pop
+		}
+	}
+}
+
+// Population returns the population of a state or a municipio given its
+// 2 or 5 digit code.
+func Population(code string) (int, bool) {
+	var (
+		pop int
+		ok  bool
+	)
+	if len(code) == 2 {
+		pop, ok = PopulationByState[code]
+	} else {
+		pop, ok = PopulationByMunicipio[code]
+	}
+	return pop, ok && pop > 0
+}
+
+// StateCode returns the id of a state given its name.
+func StateCode(name string) (string, bool) {
+	for code, sname := range StatesMap {
+		if sname == name {
+			return code, true
+		}
+	}
+	return "", false
+}
+
+// NationalPopulation returns the population of the whole country.
+func NationalPopulation() int {
+	var total int
+	for _, pop := range PopulationByState {
+		total += pop
+	}
+	return total
+}
+
+// LoadPopulation reads a CSV file with the population of states or
+// municipios, see ParsePopulation, replacing the population of the codes
+// found in the file.
+func LoadPopulation(path string) error {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	p, err := ParsePopulation(data)
+	if err != nil {
+		return fmt.Errorf("%s: %s", path, err)
+	}
+	for code, pop := range p {
+		if len(code) == 2 {
+			PopulationByState[code] = pop
+		} else {
+			PopulationByMunicipio[code] = pop
+		}
+	}
+	return nil
+}
+
+// ParsePopulation reads the population keyed by the code of the state or
+// municipio from a CSV file. Either the ITER results of the INEGI census,
+// found by their ENTIDAD, MUN, LOC and POBTOT columns, or a file with the
+// code and the population on each line (e.g. "14039,1385629") with an
+// optional header.
+func ParsePopulation(data []byte) (map[string]int, error) {
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+	if !utf8.Valid(data) {
+		data = latin1ToUTF8(data)
+	}
+
+	r := csv.NewReader(bytes.NewReader(data))
+	r.FieldsPerRecord = -1
+	r.LazyQuotes = true
+	r.TrimLeadingSpace = true
+	records, err := r.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return map[string]int{}, nil
+	}
+
+	cols := make(map[string]int)
+	for i, h := range records[0] {
+		cols[strings.ToUpper(strings.TrimSpace(h))] = i
+	}
+	entCol, hasEnt := cols["ENTIDAD"]
+	munCol, hasMun := cols["MUN"]
+	locCol, hasLoc := cols["LOC"]
+	popCol, hasPop := cols["POBTOT"]
+	iter := hasEnt && hasMun && hasLoc && hasPop
+
+	p := make(map[string]int)
+	for i, rec := range records {
+		field := func(col int) string {
+			if col >= len(rec) {
+				return ""
+			}
+			return strings.TrimSpace(rec[col])
+		}
+		var code, value string
+		if iter {
+			if i == 0 || strings.TrimLeft(field(locCol), "0") != "" {
+				// Only the totals of each municipio and state are used.
+				continue
+			}
+			ent, mun := field(entCol), field(munCol)
+			if strings.TrimLeft(ent, "0") == "" {
+				// Skip the national total.
+				continue
+			}
+			code = fmt.Sprintf("%02s", ent)
+			if strings.TrimLeft(mun, "0") != "" {
+				code += fmt.Sprintf("%03s", mun)
+			}
+			value = field(popCol)
+		} else {
+			if len(rec) < 2 {
+				return nil, fmt.Errorf("line %d: expected code and population", i+1)
+			}
+			code, value = field(0), field(1)
+		}
+
+		pop, err := strconv.Atoi(value)
+		if err != nil {
+			if i == 0 {
+				// Skip the header.
+				continue
+			}
+			return nil, fmt.Errorf("line %d: %s", i+1, err)
+		}
+		if len(code) != 2 && len(code) != 5 {
+			return nil, fmt.Errorf("line %d: invalid code %q", i+1, code)
+		}
+		p[code] = pop
+	}
+	return p, nil
+}
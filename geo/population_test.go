@@ -0,0 +1,79 @@
+package geo
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMunicipioPopulationCoverage(t *testing.T) {
+	if len(PopulationByMunicipio) == 0 {
+		t.Skip("poblacion.csv has not been generated, run go generate ./geo")
+	}
+	for code, m := range MunicipiosMexico {
+		if _, ok := Population(code); !ok {
+			t.Errorf("%s (%s): no population", code, m.Name)
+		}
+	}
+}
+
+func TestStatePopulationCoverage(t *testing.T) {
+	for code, name := range StatesMap {
+		if _, ok := Population(code); !ok {
+			t.Errorf("%s (%s): no population", code, name)
+		}
+	}
+	if got := NationalPopulation(); got != 126014024 {
+		t.Errorf("got national population %d, want 126014024", got)
+	}
+}
+
+func TestParsePopulation(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		data string
+		want map[string]int
+	}{
+		{
+			"iter",
+			"ENTIDAD,NOM_ENT,MUN,NOM_MUN,LOC,NOM_LOC,POBTOT\n" +
+				"00,Total nacional,000,Total nacional,0000,Total nacional,126014024\n" +
+				"14,Jalisco,000,Total de la entidad,0000,Total de la entidad,8348151\n" +
+				"14,Jalisco,039,Guadalajara,0000,Total del municipio,1385629\n" +
+				"14,Jalisco,039,Guadalajara,0001,Guadalajara,1385621\n",
+			map[string]int{"14": 8348151, "14039": 1385629},
+		},
+		{
+			"codes with header",
+			"\xef\xbb\xbfcode,population\n14039,1385629\n14120,1476491\n",
+			map[string]int{"14039": 1385629, "14120": 1476491},
+		},
+		{
+			"latin1",
+			"ENTIDAD,NOM_ENT,MUN,NOM_MUN,LOC,NOM_LOC,POBTOT\n" +
+				"19,Nuevo Le\xf3n,031,Ju\xe1rez,0000,Total del municipio,471523\n",
+			map[string]int{"19031": 471523},
+		},
+		{"empty", "", map[string]int{}},
+	} {
+		got, err := ParsePopulation([]byte(tt.data))
+		if err != nil {
+			t.Errorf("%s: %s", tt.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestParsePopulationErrors(t *testing.T) {
+	for _, data := range []string{
+		"14039\n",
+		"14039,1385629\n1403,100\n",
+		"14039,1385629\n14120,many\n",
+	} {
+		if _, err := ParsePopulation([]byte(data)); err == nil {
+			t.Errorf("%q: got no error", data)
+		}
+	}
+}
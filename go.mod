@@ -0,0 +1,3 @@
+module github.com/wallyqs/covid19mx
+
+go 1.18
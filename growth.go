@@ -0,0 +1,68 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/wallyqs/covid19mx/analysis"
+	"github.com/wallyqs/covid19mx/archive"
+	"github.com/wallyqs/covid19mx/report"
+)
+
+// runGrowth shows the growth rate and doubling time from the archive.
+func runGrowth(args []string) error {
+	var (
+		exportFormat string
+		archiveDir   string
+		state        string
+		window       int
+	)
+	fs := flag.NewFlagSet("growth", flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Printf("Usage: covid19mx growth [options...]\n\n")
+		fs.PrintDefaults()
+		fmt.Println()
+	}
+	fs.StringVar(&exportFormat, "o", "", "Export format (options: json, csv, table)")
+	fs.StringVar(&archiveDir, "archive", "data", "Directory with the snapshots")
+	fs.StringVar(&state, "state", "all", "States by name, alias or code (e.g. Jalisco,cdmx), 'all' for every state")
+	fs.IntVar(&window, "window", 7, "Number of days used to fit the growth rate")
+	parseOrder := sortFlags(fs)
+	fs.Parse(args)
+
+	if window < 1 {
+		return fmt.Errorf("Invalid window %d", window)
+	}
+	order, err := parseOrder()
+	if err != nil {
+		return err
+	}
+
+	store, err := archive.Open(archiveDir)
+	if err != nil {
+		return err
+	}
+	names, err := selectStates(store, state, order)
+	if err != nil {
+		return err
+	}
+	series, err := selectSeries(analysis.DailyByState(store), names)
+	if err != nil {
+		return err
+	}
+	growths := make([]analysis.StateGrowth, 0)
+	for _, s := range series {
+		growths = append(growths, analysis.ComputeGrowth(s, window))
+	}
+
+	switch exportFormat {
+	case "csv":
+		report.GrowthCSV(os.Stdout, growths)
+	case "json":
+		return report.GrowthJSON(os.Stdout, growths)
+	default:
+		report.GrowthTable(os.Stdout, growths)
+	}
+	return nil
+}
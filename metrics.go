@@ -0,0 +1,83 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/wallyqs/covid19mx/analysis"
+	"github.com/wallyqs/covid19mx/archive"
+	"github.com/wallyqs/covid19mx/geo"
+	"github.com/wallyqs/covid19mx/report"
+)
+
+// runMetrics shows how the metrics changed over time in the archive.
+func runMetrics(args []string) error {
+	var (
+		exportFormat string
+		archiveDir   string
+		state        string
+		metrics      string
+		population   string
+	)
+	fs := flag.NewFlagSet("metrics", flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Printf("Usage: covid19mx metrics [options...]\n\n")
+		fs.PrintDefaults()
+		fmt.Println()
+	}
+	fs.StringVar(&exportFormat, "o", "", "Export format (options: json, csv, table, awk)")
+	fs.StringVar(&archiveDir, "archive", "data", "Directory with the snapshots")
+	fs.StringVar(&state, "state", "", "States by name, alias or code (e.g. Jalisco,cdmx), 'all' for every state (default national)")
+	fs.StringVar(&metrics, "metrics", "", "Metrics to show (options: cfr, positivity, suspect, cases100k, deaths100k)")
+	fs.StringVar(&population, "population", "", "CSV file with the population per state code")
+	parseOrder := sortFlags(fs)
+	fs.Parse(args)
+
+	if population != "" {
+		if err := geo.LoadPopulation(population); err != nil {
+			return err
+		}
+	}
+
+	selected, err := analysis.ParseMetrics(metrics)
+	if err != nil {
+		return err
+	}
+	order, err := parseOrder()
+	if err != nil {
+		return err
+	}
+	store, err := archive.Open(archiveDir)
+	if err != nil {
+		return err
+	}
+	names, err := selectStates(store, state, order)
+	if err != nil {
+		return err
+	}
+	rows := make([]analysis.MetricsRow, 0)
+	for _, name := range names {
+		series := analysis.MetricsSeries(store, name)
+		if len(series) == 0 {
+			return fmt.Errorf("No data for state %q in the archive", name)
+		}
+		rows = append(rows, series...)
+	}
+	return showMetrics(exportFormat, rows, selected)
+}
+
+// showMetrics displays the selected metrics in the given format.
+func showMetrics(exportFormat string, rows []analysis.MetricsRow, selected []analysis.Metric) error {
+	switch exportFormat {
+	case "csv":
+		report.MetricsCSV(os.Stdout, rows, selected)
+	case "json":
+		return report.MetricsJSON(os.Stdout, rows, selected)
+	case "awk":
+		report.MetricsAwkFriendly(os.Stdout, rows, selected)
+	default:
+		report.MetricsTable(os.Stdout, rows, selected)
+	}
+	return nil
+}
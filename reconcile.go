@@ -0,0 +1,65 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/wallyqs/covid19mx/analysis"
+	"github.com/wallyqs/covid19mx/report"
+	"github.com/wallyqs/covid19mx/sinave"
+	"github.com/wallyqs/covid19mx/source"
+)
+
+// runReconcile compares the municipal data rolled up per state with the
+// state level data.
+func runReconcile(args []string) error {
+	var (
+		exportFormat string
+		uri          string
+	)
+	fs := flag.NewFlagSet("reconcile", flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Printf("Usage: covid19mx reconcile [options...]\n\n")
+		fs.PrintDefaults()
+		fmt.Println()
+	}
+	fs.StringVar(&exportFormat, "o", "", "Export format (options: json, csv, table)")
+	fs.StringVar(&uri, "source", "sinave://", "Source of the state level data")
+	setupClient := clientFlags(fs)
+	parseOrder := sortFlags(fs)
+	fs.Parse(args)
+	setupClient()
+
+	order, err := parseOrder()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	muns, err := sinave.FetchMunicipiosContext(ctx, sinave.MunicipalURL)
+	if err != nil {
+		return err
+	}
+	src, err := source.Parse(uri)
+	if err != nil {
+		return err
+	}
+	sdata, err := src.Fetch(ctx, time.Time{})
+	if err != nil {
+		return err
+	}
+
+	rows := order.Reconciliations(analysis.Reconcile(muns, sdata))
+	switch exportFormat {
+	case "csv":
+		report.ReconcileCSV(os.Stdout, rows)
+	case "json":
+		return report.ReconcileJSON(os.Stdout, rows)
+	default:
+		report.ReconcileTable(os.Stdout, rows)
+	}
+	return nil
+}
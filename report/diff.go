@@ -0,0 +1,271 @@
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/wallyqs/covid19mx/analysis"
+	"github.com/wallyqs/covid19mx/geo"
+	"github.com/wallyqs/covid19mx/sinave"
+)
+
+// StateDiff has the change in the number of cases of a state between
+// two days along with the most recent numbers.
+type StateDiff struct {
+	Name          string       `json:"name"`
+	PositiveCases int          `json:"positive"`
+	NegativeCases int          `json:"negative"`
+	SuspectCases  int          `json:"suspect"`
+	Deaths        int          `json:"deaths"`
+	Current       sinave.State `json:"current"`
+}
+
+// Diff has the change in the number of cases between two days.
+type Diff struct {
+	States []StateDiff `json:"states"`
+	Total  StateDiff   `json:"total"`
+}
+
+// NewDiff computes the change from the data of a previous day (pdata)
+// to the most recent data (sdata) for the states listed in sdata, in the
+// same order.
+func NewDiff(sdata, pdata *sinave.SinaveData) *Diff {
+	pmap := make(map[string]sinave.State)
+	for _, state := range pdata.States {
+		if state.Name == "NACIONAL" {
+			continue
+		}
+		pmap[state.Name] = state
+	}
+
+	diff := &Diff{
+		States: make([]StateDiff, 0),
+	}
+	for _, state := range sdata.States {
+		if state.Name == "NACIONAL" {
+			continue
+		}
+		pstate := pmap[state.Name]
+		diff.States = append(diff.States, StateDiff{
+			Name:          state.Name,
+			PositiveCases: state.PositiveCases - pstate.PositiveCases,
+			NegativeCases: state.NegativeCases - pstate.NegativeCases,
+			SuspectCases:  state.SuspectCases - pstate.SuspectCases,
+			Deaths:        state.Deaths - pstate.Deaths,
+			Current:       state,
+		})
+	}
+	total := sdata.TotalName()
+	diff.Total = StateDiff{Name: total, Current: sinave.State{Name: total}}
+	for _, state := range diff.States {
+		diff.Total.PositiveCases += state.PositiveCases
+		diff.Total.NegativeCases += state.NegativeCases
+		diff.Total.SuspectCases += state.SuspectCases
+		diff.Total.Deaths += state.Deaths
+		diff.Total.Current.PositiveCases += state.Current.PositiveCases
+		diff.Total.Current.NegativeCases += state.Current.NegativeCases
+		diff.Total.Current.SuspectCases += state.Current.SuspectCases
+		diff.Total.Current.Deaths += state.Current.Deaths
+	}
+	return diff
+}
+
+// TableDiff writes a table with the difference between the current
+// data and the data from a previous day.
+func TableDiff(w io.Writer, sdata, pdata *sinave.SinaveData) {
+	diff := NewDiff(sdata, pdata)
+
+	fmt.Fprintln(w, "|----------------------|-----------------|-----------------|-------------------|-------------|")
+	fmt.Fprintln(w, "| Estado               | Casos Positivos | Casos Negativos | Casos Sospechosos | Decesos     |")
+	fmt.Fprintln(w, "|----------------------|-----------------|-----------------|-------------------|-------------|")
+	for _, state := range diff.States {
+		fmt.Fprintf(w, "| %-20s | %-15s | %-15s | %-17s | %-11s |\n",
+			state.Name,
+			fmt.Sprintf("%-5d (%d)", state.PositiveCases, state.Current.PositiveCases),
+			fmt.Sprintf("%-5d (%d)", state.NegativeCases, state.Current.NegativeCases),
+			fmt.Sprintf("%-5d (%d)", state.SuspectCases, state.Current.SuspectCases),
+			fmt.Sprintf("%-5d (%d)", state.Deaths, state.Current.Deaths),
+		)
+	}
+	fmt.Fprintln(w, "|----------------------|-----------------|-----------------|-------------------|-------------|")
+	fmt.Fprintf(w, "| %-20s | %-15d | %-15d | %-17d | %-11d |\n",
+		diff.Total.Name,
+		diff.Total.PositiveCases,
+		diff.Total.NegativeCases,
+		diff.Total.SuspectCases,
+		diff.Total.Deaths,
+	)
+	fmt.Fprintln(w, "|----------------------|-----------------|-----------------|-------------------|-------------|")
+}
+
+// CSVDiff writes the difference between the current data and the data
+// from a previous day as CSV.
+func CSVDiff(w io.Writer, sdata, pdata *sinave.SinaveData) {
+	diff := NewDiff(sdata, pdata)
+
+	fmt.Fprintln(w, "\"Estado\"               , \"Casos Positivos\" , \"Casos Negativos\" , \"Casos Sospechosos\" , \"Decesos\"")
+	for _, state := range diff.States {
+		fmt.Fprintf(w, "  %-20s , %-15d , %-15d , %-17d , %-7d \n",
+			state.Name, state.PositiveCases, state.NegativeCases, state.SuspectCases, state.Deaths)
+	}
+}
+
+// JSONDiff writes the difference between the current data and the data
+// from a previous day as indented JSON.
+func JSONDiff(w io.Writer, sdata, pdata *sinave.SinaveData) error {
+	result, err := json.MarshalIndent(NewDiff(sdata, pdata), "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(w, string(result))
+	return nil
+}
+
+// AwkFriendlyDiff writes the difference between the current data and
+// the data from a previous day separated by tabs.
+func AwkFriendlyDiff(w io.Writer, sdata, pdata *sinave.SinaveData) {
+	diff := NewDiff(sdata, pdata)
+
+	for _, state := range diff.States {
+		fmt.Fprintf(w, "%-20s\t%-15d\t%-15d\t%-17d\t%-7d\n",
+			awkName(state.Name), state.PositiveCases, state.NegativeCases, state.SuspectCases, state.Deaths)
+	}
+}
+
+// MunicipioDiff has the change in the number of cases of a municipio
+// between two days along with the most recent numbers.
+type MunicipioDiff struct {
+	Code          string           `json:"code"`
+	Name          string           `json:"name"`
+	State         string           `json:"state"`
+	PositiveCases int              `json:"positive"`
+	NegativeCases int              `json:"negative"`
+	SuspectCases  int              `json:"suspect"`
+	Deaths        int              `json:"deaths"`
+	Current       sinave.Municipio `json:"current"`
+}
+
+// MunicipalDiff has the change in the number of cases per municipio
+// between two days.
+type MunicipalDiff struct {
+	Municipios []MunicipioDiff `json:"municipios"`
+	Total      MunicipioDiff   `json:"total"`
+}
+
+// NewMunicipalDiff computes the change from the municipal data of a
+// previous day (pmuns) to the most recent data (muns), sorted by the
+// most recent numbers in the given order.
+func NewMunicipalDiff(muns, pmuns map[string]sinave.Municipio, order *analysis.Order) *MunicipalDiff {
+	current := make(map[string]sinave.Municipio, len(muns))
+	for code, m := range muns {
+		current[code] = m
+	}
+	for code, pm := range pmuns {
+		if _, ok := muns[code]; !ok {
+			current[code] = sinave.Municipio{Name: pm.Name}
+		}
+	}
+	codes := order.Municipios(current)
+	total := "TOTAL"
+	if order.Partial(len(current)) {
+		total = "SUBTOTAL"
+	}
+
+	diff := &MunicipalDiff{
+		Municipios: make([]MunicipioDiff, 0, len(codes)),
+		Total:      MunicipioDiff{Name: total, Current: sinave.Municipio{Name: total}},
+	}
+	for _, code := range codes {
+		m, pm := muns[code], pmuns[code]
+		name := m.Name
+		if name == "" {
+			name = pm.Name
+		}
+		md := MunicipioDiff{
+			Code:          code,
+			Name:          name,
+			State:         geo.StateName(code[:2]),
+			PositiveCases: m.PositiveCases - pm.PositiveCases,
+			NegativeCases: m.NegativeCases - pm.NegativeCases,
+			SuspectCases:  m.SuspectCases - pm.SuspectCases,
+			Deaths:        m.Deaths - pm.Deaths,
+			Current:       m,
+		}
+		diff.Municipios = append(diff.Municipios, md)
+
+		diff.Total.PositiveCases += md.PositiveCases
+		diff.Total.NegativeCases += md.NegativeCases
+		diff.Total.SuspectCases += md.SuspectCases
+		diff.Total.Deaths += md.Deaths
+		diff.Total.Current.PositiveCases += m.PositiveCases
+		diff.Total.Current.NegativeCases += m.NegativeCases
+		diff.Total.Current.SuspectCases += m.SuspectCases
+		diff.Total.Current.Deaths += m.Deaths
+	}
+	return diff
+}
+
+// TableMunicipalDiff writes a table with the difference between the
+// current municipal data and the data from a previous day.
+func TableMunicipalDiff(w io.Writer, muns, pmuns map[string]sinave.Municipio, order *analysis.Order) {
+	diff := NewMunicipalDiff(muns, pmuns, order)
+
+	fmt.Fprintln(w, "|-------------------|-----------------|-----------------|-------------------|-------------|---------------------------|")
+	fmt.Fprintln(w, "| Estado            | Casos Positivos | Casos Negativos | Casos Sospechosos | Decesos     | Nombre                    |")
+	fmt.Fprintln(w, "|-------------------|-----------------|-----------------|-------------------|-------------|---------------------------|")
+	for _, m := range diff.Municipios {
+		fmt.Fprintf(w, "| %-17s | %-15s | %-15s | %-17s | %-11s | %s\n",
+			strings.Join(strings.Fields(m.State), ""),
+			fmt.Sprintf("%-5d (%d)", m.PositiveCases, m.Current.PositiveCases),
+			fmt.Sprintf("%-5d (%d)", m.NegativeCases, m.Current.NegativeCases),
+			fmt.Sprintf("%-5d (%d)", m.SuspectCases, m.Current.SuspectCases),
+			fmt.Sprintf("%-5d (%d)", m.Deaths, m.Current.Deaths),
+			m.Name,
+		)
+	}
+	fmt.Fprintln(w, "|-------------------|-----------------|-----------------|-------------------|-------------|")
+	fmt.Fprintf(w, "| %-17s | %-15d | %-15d | %-17d | %-11d |\n",
+		diff.Total.Name,
+		diff.Total.PositiveCases,
+		diff.Total.NegativeCases,
+		diff.Total.SuspectCases,
+		diff.Total.Deaths,
+	)
+	fmt.Fprintln(w, "|-------------------|-----------------|-----------------|-------------------|-------------|")
+}
+
+// CSVMunicipalDiff writes the difference between the current municipal
+// data and the data from a previous day as CSV.
+func CSVMunicipalDiff(w io.Writer, muns, pmuns map[string]sinave.Municipio, order *analysis.Order) {
+	diff := NewMunicipalDiff(muns, pmuns, order)
+
+	fmt.Fprintln(w, "\"Clave\" , \"Estado\"               , \"Municipio\"                , \"Casos Positivos\" , \"Casos Negativos\" , \"Casos Sospechosos\" , \"Decesos\"")
+	for _, m := range diff.Municipios {
+		fmt.Fprintf(w, "  %-5s , %-20s , %-24s , %-15d , %-15d , %-17d , %-7d \n",
+			m.Code, m.State, m.Name, m.PositiveCases, m.NegativeCases, m.SuspectCases, m.Deaths)
+	}
+}
+
+// JSONMunicipalDiff writes the difference between the current municipal
+// data and the data from a previous day as indented JSON.
+func JSONMunicipalDiff(w io.Writer, muns, pmuns map[string]sinave.Municipio, order *analysis.Order) error {
+	result, err := json.MarshalIndent(NewMunicipalDiff(muns, pmuns, order), "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(w, string(result))
+	return nil
+}
+
+// AwkFriendlyMunicipalDiff writes the difference between the current
+// municipal data and the data from a previous day separated by tabs.
+func AwkFriendlyMunicipalDiff(w io.Writer, muns, pmuns map[string]sinave.Municipio, order *analysis.Order) {
+	diff := NewMunicipalDiff(muns, pmuns, order)
+
+	for _, m := range diff.Municipios {
+		fmt.Fprintf(w, "%s\t%-20s\t%-15d\t%-15d\t%-17d\t%-7d\t%s\n",
+			m.Code, awkName(m.State), m.PositiveCases, m.NegativeCases, m.SuspectCases, m.Deaths, awkName(m.Name))
+	}
+}
@@ -0,0 +1,66 @@
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/wallyqs/covid19mx/analysis"
+)
+
+// GrowthTable writes the growth rate and doubling time of the positive
+// cases and deaths per state. States whose doubling time got shorter
+// than in the previous window are marked with '*'.
+func GrowthTable(w io.Writer, growths []analysis.StateGrowth) {
+	fmt.Fprintln(w, "|----------------------|--------------|-------------|-------------|--------------|-------------|-------------|")
+	fmt.Fprintln(w, "| Estado               | Crec. Pos. % | Duplicación | Anterior    | Crec. Dec. % | Duplicación | Anterior    |")
+	fmt.Fprintln(w, "|----------------------|--------------|-------------|-------------|--------------|-------------|-------------|")
+	for _, g := range growths {
+		name := g.Name
+		if g.Shortened {
+			name += " *"
+		}
+		fmt.Fprintf(w, "| %-20s | %-12.2f | %-11s | %-11s | %-12.2f | %-11s | %-11s |\n",
+			name,
+			g.Positive.Rate*100,
+			doublingTime(g.Positive),
+			doublingTime(g.PreviousPositive),
+			g.Deaths.Rate*100,
+			doublingTime(g.Deaths),
+			doublingTime(g.PreviousDeaths),
+		)
+	}
+	fmt.Fprintln(w, "|----------------------|--------------|-------------|-------------|--------------|-------------|-------------|")
+}
+
+// GrowthCSV writes the growth rate and doubling time of the positive
+// cases and deaths per state as CSV.
+func GrowthCSV(w io.Writer, growths []analysis.StateGrowth) {
+	fmt.Fprintln(w, "\"Estado\",\"Fecha\",\"Crecimiento Positivos\",\"Duplicacion Positivos\",\"Duplicacion Positivos Anterior\",\"Crecimiento Decesos\",\"Duplicacion Decesos\",\"Duplicacion Decesos Anterior\",\"Acelera\"")
+	for _, g := range growths {
+		fmt.Fprintf(w, "%s,%s,%.4f,%.2f,%.2f,%.4f,%.2f,%.2f,%t\n",
+			g.Name, g.Date.Format("2006-01-02"),
+			g.Positive.Rate, g.Positive.DoublingTime, g.PreviousPositive.DoublingTime,
+			g.Deaths.Rate, g.Deaths.DoublingTime, g.PreviousDeaths.DoublingTime,
+			g.Shortened,
+		)
+	}
+}
+
+// GrowthJSON writes the growth rate and doubling time of the positive
+// cases and deaths per state as indented JSON.
+func GrowthJSON(w io.Writer, growths []analysis.StateGrowth) error {
+	result, err := json.MarshalIndent(growths, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(w, string(result))
+	return nil
+}
+
+func doublingTime(g analysis.Growth) string {
+	if g.DoublingTime == 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%.1f", g.DoublingTime)
+}
@@ -0,0 +1,116 @@
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/wallyqs/covid19mx/analysis"
+)
+
+// MetricsTable writes the selected metrics as a table.
+func MetricsTable(w io.Writer, rows []analysis.MetricsRow, selected []analysis.Metric) {
+	withDate := len(rows) > 0 && rows[0].Date != ""
+	sep := "|------------------------------------------|"
+	header := fmt.Sprintf("| %-40s |", "Nombre")
+	if withDate {
+		sep += "------------|"
+		header += " Fecha      |"
+	}
+	for range selected {
+		sep += "--------------|"
+	}
+	for _, m := range selected {
+		header += fmt.Sprintf(" %-12s |", m.Title)
+	}
+
+	fmt.Fprintln(w, sep)
+	fmt.Fprintln(w, header)
+	fmt.Fprintln(w, sep)
+	for _, row := range rows {
+		line := fmt.Sprintf("| %-40s |", row.Name)
+		if withDate {
+			line += fmt.Sprintf(" %-10s |", row.Date)
+		}
+		for _, m := range selected {
+			line += fmt.Sprintf(" %-12s |", metricValue(m, row.Metrics))
+		}
+		fmt.Fprintln(w, line)
+	}
+	fmt.Fprintln(w, sep)
+}
+
+// MetricsCSV writes the selected metrics as CSV.
+func MetricsCSV(w io.Writer, rows []analysis.MetricsRow, selected []analysis.Metric) {
+	withDate := len(rows) > 0 && rows[0].Date != ""
+	header := []string{"\"Nombre\""}
+	if withDate {
+		header = append(header, "\"Fecha\"")
+	}
+	for _, m := range selected {
+		header = append(header, fmt.Sprintf("%q", m.Title))
+	}
+	fmt.Fprintln(w, strings.Join(header, ","))
+	for _, row := range rows {
+		fields := []string{fmt.Sprintf("%q", row.Name)}
+		if withDate {
+			fields = append(fields, row.Date)
+		}
+		for _, m := range selected {
+			fields = append(fields, metricValue(m, row.Metrics))
+		}
+		fmt.Fprintln(w, strings.Join(fields, ","))
+	}
+}
+
+// MetricsJSON writes the selected metrics as indented JSON.
+func MetricsJSON(w io.Writer, rows []analysis.MetricsRow, selected []analysis.Metric) error {
+	result := make([]map[string]interface{}, 0, len(rows))
+	for _, row := range rows {
+		r := map[string]interface{}{
+			"name": row.Name,
+		}
+		if row.Date != "" {
+			r["date"] = row.Date
+		}
+		for _, m := range selected {
+			if m.Known(row.Metrics) {
+				r[m.Name] = m.Value(row.Metrics)
+			} else {
+				r[m.Name] = nil
+			}
+		}
+		result = append(result, r)
+	}
+	data, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(w, string(data))
+	return nil
+}
+
+// MetricsAwkFriendly writes the selected metrics separated by tabs and
+// without spaces in the names.
+func MetricsAwkFriendly(w io.Writer, rows []analysis.MetricsRow, selected []analysis.Metric) {
+	for _, row := range rows {
+		fields := []string{awkName(row.Name)}
+		if row.Date != "" {
+			fields = append(fields, row.Date)
+		}
+		for _, m := range selected {
+			fields = append(fields, metricValue(m, row.Metrics))
+		}
+		fmt.Fprintln(w, strings.Join(fields, "\t"))
+	}
+}
+
+// metricValue formats the value of a metric, or '-' in case it is not
+// known like in perCapita.
+func metricValue(m analysis.Metric, metrics analysis.Metrics) string {
+	if !m.Known(metrics) {
+		return "-"
+	}
+	return fmt.Sprintf("%.4f", m.Value(metrics))
+}
@@ -0,0 +1,48 @@
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/wallyqs/covid19mx/sinave"
+)
+
+// Origin is where the data shown came from when it was served by one of
+// the sources of a fallback.
+type Origin struct {
+	Source string `json:"source"`
+	Date   string `json:"date"`
+
+	// Stale is set when the data is from a day before today.
+	Stale bool `json:"stale"`
+}
+
+// OriginNote writes the origin of the data below a table.
+func OriginNote(w io.Writer, o *Origin) {
+	if o.Stale {
+		fmt.Fprintf(w, "* Datos desactualizados del %s, servidos por %s\n", o.Date, o.Source)
+		return
+	}
+	fmt.Fprintf(w, "* Datos del %s, servidos por %s\n", o.Date, o.Source)
+}
+
+// OriginComment writes the origin of the data as a comment line, which
+// goes before the CSV or awk friendly output.
+func OriginComment(w io.Writer, o *Origin) {
+	fmt.Fprintf(w, "# source=%s date=%s stale=%t\n", o.Source, o.Date, o.Stale)
+}
+
+// OriginJSON writes the state level data as indented JSON along with
+// its origin.
+func OriginJSON(w io.Writer, sdata *sinave.SinaveData, o *Origin) error {
+	result, err := json.MarshalIndent(struct {
+		*Origin
+		*sinave.SinaveData
+	}{o, sdata}, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(w, string(result))
+	return nil
+}
@@ -0,0 +1,87 @@
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/wallyqs/covid19mx/analysis"
+)
+
+// ReconcileTable writes a table comparing the sum of the municipios of
+// each state with the official state numbers, as "municipal / official",
+// marking the mismatches with '*'.
+func ReconcileTable(w io.Writer, rows []*analysis.Reconciliation) {
+	fmt.Fprintln(w, "|----------------------|-------------------------|-------------------------|-------------------------|-------------------|--------------|")
+	fmt.Fprintln(w, "| Estado               | Casos Positivos         | Casos Negativos         | Casos Sospechosos       | Decesos           | Desconocidos |")
+	fmt.Fprintln(w, "|----------------------|-------------------------|-------------------------|-------------------------|-------------------|--------------|")
+	mismatches := 0
+	for _, r := range rows {
+		if r.Mismatch {
+			mismatches++
+		}
+		fmt.Fprintf(w, "| %-20s | %-23s | %-23s | %-23s | %-17s | %-12d |\n",
+			r.Name,
+			compare(r.Municipal.PositiveCases, r.Official.PositiveCases, r.HasOfficial),
+			compare(r.Municipal.NegativeCases, r.Official.NegativeCases, r.HasOfficial),
+			compare(r.Municipal.SuspectCases, r.Official.SuspectCases, r.HasOfficial),
+			compare(r.Municipal.Deaths, r.Official.Deaths, r.HasOfficial),
+			len(r.UnknownCodes),
+		)
+	}
+	fmt.Fprintln(w, "|----------------------|-------------------------|-------------------------|-------------------------|-------------------|--------------|")
+	fmt.Fprintf(w, "%d of %d states do not match\n", mismatches, len(rows))
+	for _, r := range rows {
+		if len(r.UnknownCodes) > 0 {
+			fmt.Fprintf(w, "Unknown municipios in %s: %s\n", r.Name, strings.Join(r.UnknownCodes, ", "))
+		}
+	}
+}
+
+// ReconcileCSV writes the comparison between the municipal and the
+// official numbers as CSV, with a row per state and case type.
+func ReconcileCSV(w io.Writer, rows []*analysis.Reconciliation) {
+	fmt.Fprintln(w, "\"Estado\"               , \"Tipo\"          , \"Municipal\" , \"Oficial\" , \"Diferencia\"")
+	for _, r := range rows {
+		for _, c := range []struct {
+			name      string
+			mun, offi int
+		}{
+			{"positive", r.Municipal.PositiveCases, r.Official.PositiveCases},
+			{"negative", r.Municipal.NegativeCases, r.Official.NegativeCases},
+			{"suspect", r.Municipal.SuspectCases, r.Official.SuspectCases},
+			{"deaths", r.Municipal.Deaths, r.Official.Deaths},
+		} {
+			official := "-"
+			diff := "-"
+			if r.HasOfficial {
+				official = fmt.Sprint(c.offi)
+				diff = fmt.Sprint(c.mun - c.offi)
+			}
+			fmt.Fprintf(w, "  %-20s , %-13s , %-11d , %-9s , %-10s \n", r.Name, c.name, c.mun, official, diff)
+		}
+	}
+}
+
+// ReconcileJSON writes the comparison between the municipal and the
+// official numbers as indented JSON.
+func ReconcileJSON(w io.Writer, rows []*analysis.Reconciliation) error {
+	result, err := json.MarshalIndent(rows, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(w, string(result))
+	return nil
+}
+
+// compare formats a municipal sum next to the official number.
+func compare(municipal, official int, hasOfficial bool) string {
+	if !hasOfficial {
+		return fmt.Sprintf("%d / - *", municipal)
+	}
+	if municipal != official {
+		return fmt.Sprintf("%d / %d *", municipal, official)
+	}
+	return fmt.Sprintf("%d / %d", municipal, official)
+}
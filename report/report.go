@@ -0,0 +1,205 @@
+// Package report renders the data fetched by the sinave package in the
+// different output formats supported by the covid19mx tool.
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/wallyqs/covid19mx/analysis"
+	"github.com/wallyqs/covid19mx/geo"
+	"github.com/wallyqs/covid19mx/sinave"
+)
+
+// Table writes the state level data as a table.
+func Table(w io.Writer, sdata *sinave.SinaveData) {
+	fmt.Fprintln(w, "|----------------------|-----------------|-----------------|-------------------|---------|-------------|------------|------------|--------------|")
+	fmt.Fprintln(w, "| Estado               | Casos Positivos | Casos Negativos | Casos Sospechosos | Decesos | Positividad | Incidencia | Casos/100k | Decesos/100k |")
+	fmt.Fprintln(w, "|----------------------|-----------------|-----------------|-------------------|---------|-------------|------------|------------|--------------|")
+	var totalAttackRate float64
+	for _, state := range sdata.States {
+		if state.Name == "NACIONAL" {
+			totalAttackRate = state.AttackRate
+			continue
+		}
+		population := analysis.StatePopulation(state.Name)
+		fmt.Fprintf(w, "| %-20s | %-15d | %-15d | %-17d | %-7d | %-8.4f    | %-8.2f   | %-10s | %-12s |\n",
+			state.Name,
+			state.PositiveCases,
+			state.NegativeCases,
+			state.SuspectCases,
+			state.Deaths,
+			analysis.ComputeMetrics(state.PositiveCases, state.NegativeCases, state.SuspectCases, state.Deaths, 0).Positivity,
+			state.AttackRate,
+			perCapita(state.PositiveCases, population),
+			perCapita(state.Deaths, population),
+		)
+	}
+	population := analysis.TotalPopulation(sdata)
+	fmt.Fprintln(w, "|----------------------|-----------------|-----------------|-------------------|---------|-------------|------------|------------|--------------|")
+	fmt.Fprintf(w, "| %-20s | %-15d | %-15d | %-17d | %-7d | %-8.4f    | %-8.4f   | %-10s | %-12s |\n",
+		sdata.TotalName(),
+		sdata.TotalPositiveCases(),
+		sdata.TotalNegativeCases(),
+		sdata.TotalSuspectCases(),
+		sdata.TotalDeaths(),
+		sdata.TestPositivityRate(),
+		totalAttackRate,
+		perCapita(sdata.TotalPositiveCases(), population),
+		perCapita(sdata.TotalDeaths(), population),
+	)
+	fmt.Fprintln(w, "|----------------------|-----------------|-----------------|-------------------|---------|-------------|------------|------------|--------------|")
+}
+
+// AwkFriendly writes the state level data separated by tabs and without
+// spaces in the names of the states.
+func AwkFriendly(w io.Writer, sdata *sinave.SinaveData) {
+	for _, state := range sdata.States {
+		if state.Name == "NACIONAL" {
+			continue
+		}
+		population := analysis.StatePopulation(state.Name)
+		fmt.Fprintf(w, "%-20s\t%-15d\t%-15d\t%-17d\t%-7d\t%-10s\t%-12s\n",
+			awkName(state.Name), state.PositiveCases, state.NegativeCases, state.SuspectCases, state.Deaths,
+			perCapita(state.PositiveCases, population), perCapita(state.Deaths, population))
+	}
+}
+
+// awkName removes the spaces from the name of a state so that it can be
+// used as a single field.
+func awkName(name string) string {
+	if name == "Ciudad de México" {
+		return "CDMX"
+	}
+	return strings.Join(strings.Fields(name), "")
+}
+
+// JSON writes the state level data as indented JSON.
+func JSON(w io.Writer, sdata *sinave.SinaveData) error {
+	result, err := json.MarshalIndent(sdata, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(w, string(result))
+	return nil
+}
+
+// CSV writes the state level data as CSV.
+func CSV(w io.Writer, sdata *sinave.SinaveData) {
+	fmt.Fprintln(w, "\"Estado\"               , \"Casos Positivos\" , \"Casos Negativos\" , \"Casos Sospechosos\" , \"Decesos\" , \"Casos/100k\" , \"Decesos/100k\"")
+	for _, state := range sdata.States {
+		if state.Name == "NACIONAL" {
+			continue
+		}
+		population := analysis.StatePopulation(state.Name)
+		fmt.Fprintf(w, "  %-20s , %-15d , %-15d , %-17d , %-7d , %-12s , %-14s \n",
+			state.Name, state.PositiveCases, state.NegativeCases, state.SuspectCases, state.Deaths,
+			perCapita(state.PositiveCases, population), perCapita(state.Deaths, population))
+	}
+}
+
+// MunicipalTable writes the municipal level data keyed by the code of
+// the municipio as a table, in the given order.
+func MunicipalTable(w io.Writer, muns map[string]sinave.Municipio, order *analysis.Order) {
+	var tpCases, tnCases, tsCases, tdCases int
+	var tPopulation float64
+	complete := true
+
+	fmt.Fprintln(w, "|-------------------|-----------------|-----------------|-------------------|---------|-------------|------------|--------------|---------------------------|")
+	fmt.Fprintln(w, "| Estado            | Casos Positivos | Casos Negativos | Casos Sospechosos | Decesos | Positividad | Casos/100k | Decesos/100k | Nombre                    |")
+	fmt.Fprintln(w, "|-------------------|-----------------|-----------------|-------------------|---------|-------------|------------|--------------|---------------------------|")
+	for _, s := range order.Municipios(muns) {
+		m := muns[s]
+		stateName := strings.Join(strings.Fields(geo.StateName(s[:2])), "")
+
+		population := analysis.MunicipioPopulation(s)
+		metrics := analysis.ComputeMetrics(m.PositiveCases, m.NegativeCases, m.SuspectCases, m.Deaths, population)
+		tpCases += m.PositiveCases
+		tnCases += m.NegativeCases
+		tsCases += m.SuspectCases
+		tdCases += m.Deaths
+		tPopulation += population
+		complete = complete && population > 0
+		fmt.Fprintf(w, "| %-17s | %-15d | %-15d | %-17d | %-7d | %-11.4f | %-10s | %-12s | %s\n",
+			stateName, m.PositiveCases, m.NegativeCases, m.SuspectCases, m.Deaths, metrics.Positivity,
+			perCapita(m.PositiveCases, population), perCapita(m.Deaths, population), m.Name)
+	}
+	totalPositivity := float64(tpCases) / float64(tpCases+tnCases)
+	if !complete {
+		tPopulation = 0
+	}
+	totalName := "TOTAL"
+	if order.Partial(len(muns)) {
+		totalName = "SUBTOTAL"
+	}
+
+	fmt.Fprintln(w, "|-------------------|-----------------|-----------------|-------------------|---------|-------------|------------|--------------|")
+	fmt.Fprintf(w, "| %-17s | %-15d | %-15d | %-17d | %-7d | %-11.4f | %-10s | %-12s |\n",
+		totalName, tpCases, tnCases, tsCases, tdCases, totalPositivity,
+		perCapita(tpCases, tPopulation), perCapita(tdCases, tPopulation))
+	fmt.Fprintln(w, "|-------------------|-----------------|-----------------|-------------------|---------|-------------|------------|--------------|")
+}
+
+// MunicipioData is the data of a single municipio as written by
+// MunicipalJSON.
+type MunicipioData struct {
+	Code          string `json:"code"`
+	Name          string `json:"name"`
+	State         string `json:"state"`
+	PositiveCases int    `json:"positive"`
+	NegativeCases int    `json:"negative"`
+	SuspectCases  int    `json:"suspect"`
+	Deaths        int    `json:"deaths"`
+}
+
+// MunicipalJSON writes the municipal level data as indented JSON, in
+// the given order.
+func MunicipalJSON(w io.Writer, muns map[string]sinave.Municipio, order *analysis.Order) error {
+	data := struct {
+		Municipios []MunicipioData `json:"municipios"`
+	}{
+		Municipios: make([]MunicipioData, 0, len(muns)),
+	}
+	for _, code := range order.Municipios(muns) {
+		m := muns[code]
+		data.Municipios = append(data.Municipios, MunicipioData{
+			Code:          code,
+			Name:          m.Name,
+			State:         geo.StateName(code[:2]),
+			PositiveCases: m.PositiveCases,
+			NegativeCases: m.NegativeCases,
+			SuspectCases:  m.SuspectCases,
+			Deaths:        m.Deaths,
+		})
+	}
+	result, err := json.MarshalIndent(data, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(w, string(result))
+	return nil
+}
+
+// MunicipalCSV writes the municipal level data as CSV, in the given
+// order.
+func MunicipalCSV(w io.Writer, muns map[string]sinave.Municipio, order *analysis.Order) {
+	fmt.Fprintln(w, "\"Clave\" , \"Estado\"               , \"Municipio\"                , \"Casos Positivos\" , \"Casos Negativos\" , \"Casos Sospechosos\" , \"Decesos\" , \"Casos/100k\" , \"Decesos/100k\"")
+	for _, code := range order.Municipios(muns) {
+		m := muns[code]
+		population := analysis.MunicipioPopulation(code)
+		fmt.Fprintf(w, "  %-5s , %-20s , %-24s , %-15d , %-15d , %-17d , %-7d , %-12s , %-14s \n",
+			code, geo.StateName(code[:2]), m.Name, m.PositiveCases, m.NegativeCases, m.SuspectCases, m.Deaths,
+			perCapita(m.PositiveCases, population), perCapita(m.Deaths, population))
+	}
+}
+
+// perCapita formats the number of cases per 100,000 inhabitants, or '-'
+// in case the population is not known.
+func perCapita(count int, population float64) string {
+	if population <= 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%.2f", float64(count)*100000/population)
+}
@@ -0,0 +1,50 @@
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/wallyqs/covid19mx/analysis"
+)
+
+// RtTable writes the estimates of the effective reproduction number as
+// tables, one per state.
+func RtTable(w io.Writer, rts []analysis.StateRt) {
+	for i, rt := range rts {
+		if i > 0 {
+			fmt.Fprintln(w)
+		}
+		fmt.Fprintln(w, rt.Name)
+		fmt.Fprintln(w, "|------------|---------|----------|----------|-----------------|")
+		fmt.Fprintln(w, "| Fecha      | Rt      | Inferior | Superior | Casos Positivos |")
+		fmt.Fprintln(w, "|------------|---------|----------|----------|-----------------|")
+		for _, e := range rt.Estimates {
+			fmt.Fprintf(w, "| %s | %-7.3f | %-8.3f | %-8.3f | %-15d |\n",
+				e.Date.Format("2006-01-02"), e.Mean, e.Lower, e.Upper, e.Cases)
+		}
+		fmt.Fprintln(w, "|------------|---------|----------|----------|-----------------|")
+	}
+}
+
+// RtCSV writes the estimates of the effective reproduction number as CSV.
+func RtCSV(w io.Writer, rts []analysis.StateRt) {
+	fmt.Fprintln(w, "\"Estado\",\"Fecha\",\"Rt\",\"Inferior\",\"Superior\",\"Casos Positivos\"")
+	for _, rt := range rts {
+		for _, e := range rt.Estimates {
+			fmt.Fprintf(w, "%s,%s,%.4f,%.4f,%.4f,%d\n",
+				rt.Name, e.Date.Format("2006-01-02"), e.Mean, e.Lower, e.Upper, e.Cases)
+		}
+	}
+}
+
+// RtJSON writes the estimates of the effective reproduction number as
+// indented JSON.
+func RtJSON(w io.Writer, rts []analysis.StateRt) error {
+	result, err := json.MarshalIndent(rts, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(w, string(result))
+	return nil
+}
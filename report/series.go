@@ -0,0 +1,62 @@
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/wallyqs/covid19mx/analysis"
+)
+
+// SeriesTable writes the daily series of new cases as tables, one per
+// state. Days that were missing in the archive are marked with '*'.
+func SeriesTable(w io.Writer, series []analysis.StateSeries) {
+	for i, s := range series {
+		if i > 0 {
+			fmt.Fprintln(w)
+		}
+		fmt.Fprintln(w, s.Name)
+		fmt.Fprintln(w, "|-------------|------------------|-----------|-----------|----------------|-----------|-----------|----------------|-----------|-----------|")
+		fmt.Fprintln(w, "| Fecha       | Nuevos Positivos | Prom. 7d  | Prom. 14d | Nuevos Decesos | Prom. 7d  | Prom. 14d | Nuevas Pruebas | Prom. 7d  | Prom. 14d |")
+		fmt.Fprintln(w, "|-------------|------------------|-----------|-----------|----------------|-----------|-----------|----------------|-----------|-----------|")
+		for _, d := range s.Days {
+			mark := " "
+			if d.Interpolated {
+				mark = "*"
+			}
+			fmt.Fprintf(w, "| %s%s | %-16d | %-9.2f | %-9.2f | %-14d | %-9.2f | %-9.2f | %-14d | %-9.2f | %-9.2f |\n",
+				d.Date.Format("2006-01-02"), mark,
+				d.NewPositive, d.Positive7d, d.Positive14d,
+				d.NewDeaths, d.Deaths7d, d.Deaths14d,
+				d.NewTests, d.Tests7d, d.Tests14d,
+			)
+		}
+		fmt.Fprintln(w, "|-------------|------------------|-----------|-----------|----------------|-----------|-----------|----------------|-----------|-----------|")
+	}
+}
+
+// SeriesCSV writes the daily series of new cases as CSV.
+func SeriesCSV(w io.Writer, series []analysis.StateSeries) {
+	fmt.Fprintln(w, "\"Estado\",\"Fecha\",\"Nuevos Positivos\",\"Positivos 7d\",\"Positivos 14d\",\"Nuevos Decesos\",\"Decesos 7d\",\"Decesos 14d\",\"Nuevas Pruebas\",\"Pruebas 7d\",\"Pruebas 14d\",\"Interpolado\"")
+	for _, s := range series {
+		for _, d := range s.Days {
+			fmt.Fprintf(w, "%s,%s,%d,%.2f,%.2f,%d,%.2f,%.2f,%d,%.2f,%.2f,%t\n",
+				s.Name, d.Date.Format("2006-01-02"),
+				d.NewPositive, d.Positive7d, d.Positive14d,
+				d.NewDeaths, d.Deaths7d, d.Deaths14d,
+				d.NewTests, d.Tests7d, d.Tests14d,
+				d.Interpolated,
+			)
+		}
+	}
+}
+
+// SeriesJSON writes the daily series of new cases as indented JSON.
+func SeriesJSON(w io.Writer, series []analysis.StateSeries) error {
+	result, err := json.MarshalIndent(series, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(w, string(result))
+	return nil
+}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/wallyqs/covid19mx/analysis"
+	"github.com/wallyqs/covid19mx/archive"
+	"github.com/wallyqs/covid19mx/report"
+)
+
+// runRt estimates the effective reproduction number from the archive.
+func runRt(args []string) error {
+	var (
+		exportFormat string
+		archiveDir   string
+		state        string
+	)
+	opts := analysis.DefaultRtOptions
+	fs := flag.NewFlagSet("rt", flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Printf("Usage: covid19mx rt [options...]\n\n")
+		fs.PrintDefaults()
+		fmt.Println()
+	}
+	fs.StringVar(&exportFormat, "o", "", "Export format (options: json, csv, table)")
+	fs.StringVar(&archiveDir, "archive", "data", "Directory with the snapshots")
+	fs.StringVar(&state, "state", "", "States by name, alias or code (e.g. Jalisco,cdmx), 'all' for every state (default national)")
+	fs.IntVar(&opts.Window, "window", opts.Window, "Number of days over which Rt is assumed constant")
+	fs.Float64Var(&opts.SerialInterval.Mean, "si-mean", opts.SerialInterval.Mean, "Mean of the serial interval in days")
+	fs.Float64Var(&opts.SerialInterval.SD, "si-sd", opts.SerialInterval.SD, "Standard deviation of the serial interval in days")
+	fs.Float64Var(&opts.CredibleLevel, "ci", opts.CredibleLevel, "Probability covered by the credible interval")
+	parseOrder := sortFlags(fs)
+	fs.Parse(args)
+
+	if opts.Window < 1 || opts.SerialInterval.Mean <= 0 || opts.SerialInterval.SD <= 0 {
+		return fmt.Errorf("Invalid window or serial interval")
+	}
+	if opts.CredibleLevel <= 0 || opts.CredibleLevel >= 1 {
+		return fmt.Errorf("Credible level must be between 0 and 1")
+	}
+
+	order, err := parseOrder()
+	if err != nil {
+		return err
+	}
+	store, err := archive.Open(archiveDir)
+	if err != nil {
+		return err
+	}
+	names, err := selectStates(store, state, order)
+	if err != nil {
+		return err
+	}
+	series, err := selectSeries(analysis.DailyByState(store), names)
+	if err != nil {
+		return err
+	}
+	rts := make([]analysis.StateRt, 0)
+	for _, s := range series {
+		rts = append(rts, analysis.StateRt{
+			Name:      s.Name,
+			Estimates: analysis.EstimateRt(s.Days, opts),
+		})
+	}
+
+	switch exportFormat {
+	case "csv":
+		report.RtCSV(os.Stdout, rts)
+	case "json":
+		return report.RtJSON(os.Stdout, rts)
+	default:
+		report.RtTable(os.Stdout, rts)
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/wallyqs/covid19mx/analysis"
+	"github.com/wallyqs/covid19mx/archive"
+	"github.com/wallyqs/covid19mx/report"
+	"github.com/wallyqs/covid19mx/sinave"
+)
+
+// runSeries shows the daily new cases from the archive.
+func runSeries(args []string) error {
+	var (
+		exportFormat string
+		archiveDir   string
+		state        string
+	)
+	fs := flag.NewFlagSet("series", flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Printf("Usage: covid19mx series [options...]\n\n")
+		fs.PrintDefaults()
+		fmt.Println()
+	}
+	fs.StringVar(&exportFormat, "o", "", "Export format (options: json, csv, table)")
+	fs.StringVar(&archiveDir, "archive", "data", "Directory with the snapshots")
+	fs.StringVar(&state, "state", "", "States by name, alias or code (e.g. Jalisco,cdmx), 'all' for every state (default national)")
+	parseOrder := sortFlags(fs)
+	fs.Parse(args)
+
+	order, err := parseOrder()
+	if err != nil {
+		return err
+	}
+
+	store, err := archive.Open(archiveDir)
+	if err != nil {
+		return err
+	}
+	names, err := selectStates(store, state, order)
+	if err != nil {
+		return err
+	}
+	series, err := selectSeries(analysis.DailyByState(store), names)
+	if err != nil {
+		return err
+	}
+
+	switch exportFormat {
+	case "csv":
+		report.SeriesCSV(os.Stdout, series)
+	case "json":
+		return report.SeriesJSON(os.Stdout, series)
+	default:
+		report.SeriesTable(os.Stdout, series)
+	}
+	return nil
+}
+
+// selectStates returns the names of the states given by name, alias or
+// code, every state and the national data for "all", or the national
+// data by default. The states are sorted by their latest numbers in the
+// archive and the national data goes last, unless only the top states
+// are shown.
+func selectStates(store *archive.Store, state string, order *analysis.Order) ([]string, error) {
+	var names []string
+	switch state {
+	case "":
+		return []string{analysis.National}, nil
+	case "all":
+		names = store.States()
+	default:
+		var err error
+		names, err = stateNames(state)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	latest, _, err := store.Latest()
+	if err != nil {
+		return nil, err
+	}
+	sdata := &sinave.SinaveData{States: make([]sinave.State, 0, len(names))}
+	for _, name := range names {
+		// States missing from the latest snapshot are still listed so
+		// that the lack of data is reported.
+		s := sinave.State{Name: name}
+		for _, ls := range latest.States {
+			if ls.Name == name {
+				s = ls
+			}
+		}
+		sdata.States = append(sdata.States, s)
+	}
+	sdata = order.States(sdata)
+
+	sorted := make([]string, 0, len(sdata.States)+1)
+	for _, s := range sdata.States {
+		sorted = append(sorted, s.Name)
+	}
+	if state == "all" && !sdata.Partial {
+		sorted = append(sorted, analysis.National)
+	}
+	return sorted, nil
+}
+
+// selectSeries narrows down the series to the ones of the given states.
+func selectSeries(series []analysis.StateSeries, names []string) ([]analysis.StateSeries, error) {
+	selected := make([]analysis.StateSeries, 0, len(names))
+	for _, name := range names {
+		found := false
+		for _, s := range series {
+			if s.Name == name {
+				selected = append(selected, s)
+				found = true
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("No data for state %q in the archive", name)
+		}
+	}
+	return selected, nil
+}
@@ -0,0 +1,163 @@
+package sinave
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// DefaultCacheTTL is how long the responses are reused by default.
+const DefaultCacheTTL = 30 * time.Minute
+
+// Cache keeps the successful responses on disk so that repeated
+// requests with the same endpoint and form values are not sent again
+// while they are fresh.
+type Cache struct {
+	Dir string
+	TTL time.Duration
+}
+
+// CacheEntry is a response stored in the cache or in a recording.
+type CacheEntry struct {
+	Method     string      `json:"method"`
+	URL        string      `json:"url"`
+	Request    string      `json:"request,omitempty"`
+	FetchedAt  time.Time   `json:"fetched_at"`
+	StatusCode int         `json:"status,omitempty"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       []byte      `json:"body,omitempty"`
+
+	// Path is the file of the entry in the cache directory.
+	Path string `json:"-"`
+}
+
+// DefaultCacheDir returns $XDG_CACHE_HOME/covid19mx, or the equivalent
+// directory of the platform.
+func DefaultCacheDir() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "covid19mx"), nil
+}
+
+// Get returns the body of a fresh response to the same request.
+func (c *Cache) Get(method, endpoint string, body []byte) ([]byte, bool) {
+	entry, err := readEntry(entryPath(c.Dir, method, endpoint, body))
+	if err != nil {
+		return nil, false
+	}
+	if time.Since(entry.FetchedAt) > c.TTL {
+		return nil, false
+	}
+	return entry.Body, true
+}
+
+// Put stores the body of the response to a request.
+func (c *Cache) Put(method, endpoint string, body, resp []byte) error {
+	return writeEntry(c.Dir, &CacheEntry{
+		Method:    method,
+		URL:       endpoint,
+		Request:   string(body),
+		FetchedAt: time.Now().UTC(),
+		Body:      resp,
+	})
+}
+
+// Entries returns the responses in the cache, the most recent first.
+func (c *Cache) Entries() ([]CacheEntry, error) {
+	files, err := filepath.Glob(filepath.Join(c.Dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]CacheEntry, 0)
+	for _, path := range files {
+		entry, err := readEntry(path)
+		if err != nil {
+			continue
+		}
+		entries = append(entries, *entry)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].FetchedAt.After(entries[j].FetchedAt)
+	})
+	return entries, nil
+}
+
+// Clear removes every response from the cache and returns how many
+// were removed.
+func (c *Cache) Clear() (int, error) {
+	files, err := filepath.Glob(filepath.Join(c.Dir, "*.json"))
+	if err != nil {
+		return 0, err
+	}
+	for i, path := range files {
+		if err := os.Remove(path); err != nil {
+			return i, err
+		}
+	}
+	return len(files), nil
+}
+
+// entryPath returns the file of a request, keyed by the method, the
+// endpoint and the form values.
+func entryPath(dir, method, endpoint string, body []byte) string {
+	h := sha256.New()
+	h.Write([]byte(strings.Join([]string{method, endpoint, ""}, "\n")))
+	h.Write(body)
+	return filepath.Join(dir, hex.EncodeToString(h.Sum(nil))+".json")
+}
+
+func readEntry(path string) (*CacheEntry, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var entry CacheEntry
+	if err := json.Unmarshal(b, &entry); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	entry.Path = path
+	return &entry, nil
+}
+
+// writeEntry stores an entry into a directory, keyed by its request.
+func writeEntry(dir string, entry *CacheEntry) error {
+	b, err := json.MarshalIndent(entry, "", "  ")
+	if err != nil {
+		return err
+	}
+	return writeFile(dir, entryPath(dir, entry.Method, entry.URL, []byte(entry.Request)), b)
+}
+
+// writeFile writes a file of the given directory atomically.
+func writeFile(dir, path string, b []byte) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+
+	// Write into a temporary file first so that concurrent runs never
+	// read a partial entry.
+	f, err := ioutil.TempFile(dir, ".tmp-")
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(b); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return err
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+	return os.Rename(f.Name(), path)
+}
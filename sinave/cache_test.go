@@ -0,0 +1,113 @@
+package sinave
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestCacheExpires(t *testing.T) {
+	c := &Cache{Dir: t.TempDir(), TTL: time.Hour}
+	form := []byte("sPatType=Confirmados")
+	if err := c.Put("POST", MunicipalURL, form, []byte("fresh")); err != nil {
+		t.Fatal(err)
+	}
+	if b, ok := c.Get("POST", MunicipalURL, form); !ok || string(b) != "fresh" {
+		t.Errorf("got %q, %t, want the fresh response", b, ok)
+	}
+
+	err := writeEntry(c.Dir, &CacheEntry{
+		Method:    "POST",
+		URL:       MunicipalURL,
+		Request:   string(form),
+		FetchedAt: time.Now().Add(-2 * time.Hour),
+		Body:      []byte("stale"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b, ok := c.Get("POST", MunicipalURL, form); ok {
+		t.Errorf("got %q, want the expired response to be skipped", b)
+	}
+}
+
+func TestCacheKeys(t *testing.T) {
+	c := &Cache{Dir: t.TempDir(), TTL: time.Hour}
+	if err := c.Put("POST", MunicipalURL, []byte("sPatType=Confirmados"), []byte("confirmados")); err != nil {
+		t.Fatal(err)
+	}
+	for _, tt := range []struct {
+		method, endpoint, form string
+	}{
+		{"POST", MunicipalURL, "sPatType=Negativos"},
+		{"POST", MunicipalURL, ""},
+		{"GET", MunicipalURL, "sPatType=Confirmados"},
+		{"POST", AttackRateURL, "sPatType=Confirmados"},
+	} {
+		if b, ok := c.Get(tt.method, tt.endpoint, []byte(tt.form)); ok {
+			t.Errorf("%s %s %q: got the response %q of another request", tt.method, tt.endpoint, tt.form, b)
+		}
+	}
+
+	entries, err := c.Entries()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 || entries[0].Request != "sPatType=Confirmados" || string(entries[0].Body) != "confirmados" {
+		t.Errorf("got entries %+v", entries)
+	}
+	n, err := c.Clear()
+	if err != nil || n != 1 {
+		t.Errorf("got %d removed (%v), want 1", n, err)
+	}
+	if entries, _ := c.Entries(); len(entries) != 0 {
+		t.Errorf("got entries %+v after clearing the cache", entries)
+	}
+}
+
+func TestClientUsesCache(t *testing.T) {
+	var attempts int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		if r.FormValue("sPatType") == "Defunciones" {
+			http.Error(w, "failed", http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(r.FormValue("sPatType")))
+	}))
+	defer ts.Close()
+
+	c := testClient(time.Second, 0)
+	c.Cache = &Cache{Dir: t.TempDir(), TTL: time.Hour}
+	ctx := context.Background()
+	do := func(form string) string {
+		t.Helper()
+		resp, err := c.Do(ctx, "POST", ts.URL, "application/x-www-form-urlencoded", []byte(form))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(resp.Body)
+	}
+
+	for i := 0; i < 2; i++ {
+		if got := do("sPatType=Confirmados"); got != "Confirmados" {
+			t.Errorf("got %q, want \"Confirmados\"", got)
+		}
+	}
+	if got := do("sPatType=Negativos"); got != "Negativos" {
+		t.Errorf("got %q, want \"Negativos\"", got)
+	}
+	if got := atomic.LoadInt32(&attempts); got != 2 {
+		t.Errorf("got %d requests, want 2", got)
+	}
+
+	// Failed responses are not cached.
+	do("sPatType=Defunciones")
+	do("sPatType=Defunciones")
+	if got := atomic.LoadInt32(&attempts); got != 4 {
+		t.Errorf("got %d requests, want 4", got)
+	}
+}
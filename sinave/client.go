@@ -0,0 +1,179 @@
+package sinave
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+)
+
+const (
+	// UserAgent identifies the requests made to the SINAVE endpoints.
+	UserAgent = "covid19mx (+https://github.com/wallyqs/covid19mx)"
+
+	DefaultTimeout = 30 * time.Second
+	DefaultRetries = 3
+	DefaultBackoff = 500 * time.Millisecond
+)
+
+// Client makes the requests to the SINAVE endpoints, retrying with
+// exponential backoff when the server fails or the request times out.
+type Client struct {
+	HTTPClient *http.Client
+
+	// Retries is the number of times a request is retried after the
+	// first attempt fails.
+	Retries int
+
+	// Backoff is the wait before the first retry, doubled on every
+	// following retry.
+	Backoff time.Duration
+
+	UserAgent string
+
+	// Cache reuses the successful responses when set.
+	Cache *Cache
+
+	// Record saves every response into a recording when set.
+	Record *Recording
+
+	// Replay serves the responses from a recording instead of sending
+	// the requests when set.
+	Replay *Recording
+}
+
+// DefaultClient is the client used by the Fetch functions.
+var DefaultClient = NewClient(DefaultTimeout, DefaultRetries)
+
+// NewClient returns a client with a timeout for each attempt.
+func NewClient(timeout time.Duration, retries int) *Client {
+	return &Client{
+		HTTPClient: &http.Client{Timeout: timeout},
+		Retries:    retries,
+		Backoff:    DefaultBackoff,
+		UserAgent:  UserAgent,
+	}
+}
+
+// Response is the status, the headers and the whole body of a response.
+type Response struct {
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+}
+
+// Do sends a request and reads the whole response, retrying on errors
+// from the server (5xx and 429) and on network errors. The body can be
+// nil, otherwise it is sent with the given content type. Fresh responses
+// are served from the cache when there is one.
+func (c *Client) Do(ctx context.Context, method, endpoint, contentType string, body []byte) (*Response, error) {
+	if c.Replay != nil {
+		return c.Replay.Load(method, endpoint, body)
+	}
+	if c.Cache != nil {
+		if b, ok := c.Cache.Get(method, endpoint, body); ok {
+			return &Response{StatusCode: http.StatusOK, Body: b}, nil
+		}
+	}
+	resp, err := c.retry(ctx, method, endpoint, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	if c.Record != nil {
+		if err := c.Record.Save(method, endpoint, body, resp); err != nil {
+			return nil, err
+		}
+	}
+	if c.Cache != nil && resp.StatusCode == http.StatusOK {
+		// Failing to cache the response should not fail the request.
+		c.Cache.Put(method, endpoint, body, resp.Body)
+	}
+	return resp, nil
+}
+
+func (c *Client) retry(ctx context.Context, method, endpoint, contentType string, body []byte) (*Response, error) {
+	backoff := c.Backoff
+	for attempt := 0; ; attempt++ {
+		resp, err := c.do(ctx, method, endpoint, contentType, body)
+		if err == nil && !retryStatus(resp.StatusCode) {
+			return resp, nil
+		}
+		if err != nil && !retryError(ctx, err) {
+			return nil, err
+		}
+		if attempt >= c.Retries {
+			if err != nil {
+				return nil, err
+			}
+			return resp, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+func (c *Client) do(ctx context.Context, method, endpoint, contentType string, body []byte) (*Response, error) {
+	var r io.Reader
+	if body != nil {
+		r = bytes.NewReader(body)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, r)
+	if err != nil {
+		return nil, err
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	if c.UserAgent != "" {
+		req.Header.Set("User-Agent", c.UserAgent)
+	}
+
+	hc := c.HTTPClient
+	if hc == nil {
+		hc = http.DefaultClient
+	}
+	resp, err := hc.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", endpoint, err)
+	}
+	return &Response{StatusCode: resp.StatusCode, Header: resp.Header, Body: b}, nil
+}
+
+func retryStatus(code int) bool {
+	return code >= 500 || code == http.StatusTooManyRequests
+}
+
+// retryError reports whether the request can be retried after an error,
+// which is only the case for timeouts and dropped connections. Errors
+// like a failed TLS handshake or an unknown host are not retried, nor
+// any error once the context has been cancelled.
+func retryError(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	var nerr net.Error
+	if errors.As(err, &nerr) && nerr.Timeout() {
+		return true
+	}
+	return errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, io.EOF)
+}
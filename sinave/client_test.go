@@ -0,0 +1,188 @@
+package sinave
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// flakyServer fails the first requests with the given handler and then
+// responds with "ok", counting the attempts.
+func flakyServer(t *testing.T, failures int32, fail http.HandlerFunc) (*httptest.Server, *int32) {
+	t.Helper()
+	var attempts int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) <= failures {
+			fail(w, r)
+			return
+		}
+		w.Write([]byte("ok"))
+	}))
+	t.Cleanup(ts.Close)
+	return ts, &attempts
+}
+
+func testClient(timeout time.Duration, retries int) *Client {
+	c := NewClient(timeout, retries)
+	c.Backoff = time.Millisecond
+	return c
+}
+
+func TestClientRetriesServerErrors(t *testing.T) {
+	var agents []string
+	ts, attempts := flakyServer(t, 2, func(w http.ResponseWriter, r *http.Request) {
+		agents = append(agents, r.UserAgent())
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	resp, err := testClient(time.Second, 3).Do(context.Background(), "GET", ts.URL, "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusOK || string(resp.Body) != "ok" {
+		t.Errorf("got %d %q, want 200 \"ok\"", resp.StatusCode, resp.Body)
+	}
+	if got := atomic.LoadInt32(attempts); got != 3 {
+		t.Errorf("got %d attempts, want 3", got)
+	}
+	for i, agent := range agents {
+		if agent != UserAgent {
+			t.Errorf("attempt %d: got User-Agent %q, want %q", i+1, agent, UserAgent)
+		}
+	}
+}
+
+func TestClientGivesUpAfterRetries(t *testing.T) {
+	ts, attempts := flakyServer(t, 10, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	resp, err := testClient(time.Second, 2).Do(context.Background(), "GET", ts.URL, "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("got status %d, want 503", resp.StatusCode)
+	}
+	if got := atomic.LoadInt32(attempts); got != 3 {
+		t.Errorf("got %d attempts, want 3", got)
+	}
+}
+
+func TestClientRetriesTimeouts(t *testing.T) {
+	ts, attempts := flakyServer(t, 1, func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+		}
+	})
+
+	resp, err := testClient(50*time.Millisecond, 1).Do(context.Background(), "GET", ts.URL, "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(resp.Body) != "ok" {
+		t.Errorf("got body %q, want \"ok\"", resp.Body)
+	}
+	if got := atomic.LoadInt32(attempts); got != 2 {
+		t.Errorf("got %d attempts, want 2", got)
+	}
+}
+
+func TestClientCancelDuringBackoff(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ts, attempts := flakyServer(t, 10, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		// The client is waiting to retry by the time this runs.
+		time.AfterFunc(20*time.Millisecond, cancel)
+	})
+
+	c := testClient(time.Second, 3)
+	c.Backoff = time.Hour
+	start := time.Now()
+	_, err := c.Do(ctx, "GET", ts.URL, "", nil)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("got error %v, want %v", err, context.Canceled)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("took %s to notice the cancellation", elapsed)
+	}
+	if got := atomic.LoadInt32(attempts); got != 1 {
+		t.Errorf("got %d attempts, want 1", got)
+	}
+}
+
+func TestClientDoesNotRetryClientErrors(t *testing.T) {
+	for _, code := range []int{http.StatusBadRequest, http.StatusNotFound} {
+		ts, attempts := flakyServer(t, 10, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(code)
+		})
+
+		resp, err := testClient(time.Second, 3).Do(context.Background(), "GET", ts.URL, "", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.StatusCode != code {
+			t.Errorf("got status %d, want %d", resp.StatusCode, code)
+		}
+		if got := atomic.LoadInt32(attempts); got != 1 {
+			t.Errorf("status %d: got %d attempts, want 1", code, got)
+		}
+	}
+}
+
+func TestClientRetriesRefusedConnections(t *testing.T) {
+	// Take a free port and close it so that the connections are refused.
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := l.Addr().String()
+	l.Close()
+
+	var attempts int32
+	c := testClient(time.Second, 2)
+	c.HTTPClient.Transport = &http.Transport{
+		DialContext: func(ctx context.Context, network, _ string) (net.Conn, error) {
+			atomic.AddInt32(&attempts, 1)
+			var d net.Dialer
+			return d.DialContext(ctx, network, addr)
+		},
+	}
+	if _, err := c.Do(context.Background(), "GET", "http://"+addr, "", nil); err == nil {
+		t.Fatal("got no error, want the connection to be refused")
+	}
+	if got := atomic.LoadInt32(&attempts); got != 3 {
+		t.Errorf("got %d attempts, want 3", got)
+	}
+}
+
+func TestClientDoesNotRetryTransportErrors(t *testing.T) {
+	var conns int32
+	ts := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("ok"))
+	}))
+	ts.Config.ConnState = func(_ net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			atomic.AddInt32(&conns, 1)
+		}
+	}
+	ts.StartTLS()
+
+	// The certificate of the test server is not trusted by the client.
+	_, err := testClient(time.Second, 3).Do(context.Background(), "GET", ts.URL, "", nil)
+	if err == nil {
+		t.Fatal("got no error, want the certificate to be rejected")
+	}
+	// Closing waits for the connections to be tracked.
+	ts.Close()
+	if got := atomic.LoadInt32(&conns); got != 1 {
+		t.Errorf("got %d attempts, want 1", got)
+	}
+}
@@ -0,0 +1,196 @@
+package sinave
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// KnownGraficas are the endpoints referenced by the map page that
+// DetectLatestDataSource knows about.
+var KnownGraficas = []string{"Grafica22", "Grafica23"}
+
+var graficaRe = regexp.MustCompile(`Grafica[0-9]+`)
+
+// FindGraficas returns the GraficaNN endpoints referenced by a page,
+// sorted and without duplicates.
+func FindGraficas(page []byte) []string {
+	seen := make(map[string]bool)
+	found := make([]string, 0)
+	for _, m := range graficaRe.FindAll(page, -1) {
+		if !seen[string(m)] {
+			seen[string(m)] = true
+			found = append(found, string(m))
+		}
+	}
+	sort.Strings(found)
+	return found
+}
+
+// Probe is the result of checking one of the upstream endpoints.
+type Probe struct {
+	URL        string
+	StatusCode int
+
+	// Rows is the number of rows, or of municipios, in the response.
+	Rows int
+
+	// Columns are the distinct number of columns of the rows.
+	Columns []int
+
+	// Graficas are the endpoints referenced by the map page.
+	Graficas []string
+
+	// Err is set when the endpoint could not be reached or the response
+	// could not be parsed.
+	Err error
+}
+
+// OK reports whether the endpoint responded with data that could be
+// parsed.
+func (p *Probe) OK() bool {
+	return p.Err == nil && p.StatusCode == 200
+}
+
+// Checkup is the result of probing all the upstream endpoints.
+type Checkup struct {
+	Probes []*Probe
+
+	// Drift describes every difference found with respect to what this
+	// package expects, it is empty when everything looks fine.
+	Drift []string
+}
+
+// Diagnose probes the map page, the Grafica endpoints, the attack rate
+// endpoint and the municipal endpoint to detect changes upstream.
+func Diagnose(ctx context.Context) *Checkup {
+	c := &Checkup{Drift: make([]string, 0)}
+
+	page := probeMap(ctx)
+	c.Probes = append(c.Probes, page)
+	if !page.OK() {
+		c.Drift = append(c.Drift, fmt.Sprintf("%s: %s", MapURL, page.failure()))
+	} else {
+		for _, g := range page.Graficas {
+			if !contains(KnownGraficas, g) {
+				c.Drift = append(c.Drift, fmt.Sprintf("%s: references unknown endpoint %s", MapURL, g))
+			}
+		}
+		if len(page.Graficas) == 0 {
+			c.Drift = append(c.Drift, fmt.Sprintf("%s: does not reference any Grafica endpoint", MapURL))
+		}
+	}
+
+	// Only one of the Grafica endpoints needs to have the latest data,
+	// but any of them responding with a different schema is drift.
+	responding := 0
+	for _, endpoint := range []string{URLA, URLB, AttackRateURL} {
+		p := probeStates(ctx, endpoint)
+		c.Probes = append(c.Probes, p)
+		switch {
+		case p.OK():
+			if endpoint != AttackRateURL {
+				responding++
+			}
+		case p.StatusCode == 200 || endpoint == AttackRateURL:
+			c.Drift = append(c.Drift, fmt.Sprintf("%s: %s", endpoint, p.failure()))
+		}
+	}
+	if responding == 0 {
+		c.Drift = append(c.Drift, "None of the Grafica endpoints responded with data")
+	}
+
+	mun := probeMunicipios(ctx)
+	c.Probes = append(c.Probes, mun)
+	if !mun.OK() {
+		c.Drift = append(c.Drift, fmt.Sprintf("%s: %s", MunicipalURL, mun.failure()))
+	}
+	return c
+}
+
+func (p *Probe) failure() string {
+	if p.Err != nil {
+		return p.Err.Error()
+	}
+	return fmt.Sprintf("unexpected status %d", p.StatusCode)
+}
+
+func probeMap(ctx context.Context) *Probe {
+	p := &Probe{URL: MapURL}
+	resp, err := DefaultClient.Do(ctx, "GET", MapURL, "", nil)
+	if err != nil {
+		p.Err = err
+		return p
+	}
+	p.StatusCode = resp.StatusCode
+	p.Graficas = FindGraficas(resp.Body)
+	return p
+}
+
+func probeStates(ctx context.Context, endpoint string) *Probe {
+	p := &Probe{URL: endpoint}
+	resp, err := DefaultClient.Do(ctx, "POST", endpoint, "application/json; charset=UTF-8", nil)
+	if err != nil {
+		p.Err = err
+		return p
+	}
+	p.StatusCode = resp.StatusCode
+	if resp.StatusCode != 200 {
+		return p
+	}
+
+	rows, err := DecodeRows(resp.Body)
+	if err != nil {
+		p.Err = err
+		return p
+	}
+	p.Rows = len(rows)
+	widths := make(map[int]bool)
+	for _, row := range rows {
+		if !widths[len(row)] {
+			widths[len(row)] = true
+			p.Columns = append(p.Columns, len(row))
+		}
+	}
+	sort.Ints(p.Columns)
+	if _, errs := Validate(resp.Body); len(errs) > 0 {
+		p.Err = fmt.Errorf("%d rows do not match the schema, first: %s", len(errs), errs[0])
+	}
+	return p
+}
+
+func probeMunicipios(ctx context.Context) *Probe {
+	p := &Probe{URL: MunicipalURL}
+	vals := url.Values{"sPatType": {"Confirmados"}}
+	resp, err := DefaultClient.Do(ctx, "POST", MunicipalURL, "application/x-www-form-urlencoded", []byte(vals.Encode()))
+	if err != nil {
+		p.Err = err
+		return p
+	}
+	p.StatusCode = resp.StatusCode
+	if resp.StatusCode != 200 {
+		return p
+	}
+	muns, err := ParseScript(string(resp.Body))
+	if err != nil {
+		p.Err = err
+		return p
+	}
+	p.Rows = len(muns)
+	if p.Rows == 0 {
+		p.Err = fmt.Errorf("no municipios found in the response")
+	}
+	return p
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if strings.EqualFold(v, s) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,107 @@
+package sinave
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// FetchData gets the latest state level data from one of the SINAVE
+// endpoints, e.g. AttackRateURL.
+func FetchData(endpoint string) (*SinaveData, error) {
+	return FetchDataContext(context.Background(), endpoint)
+}
+
+// FetchDataContext is like FetchData but can be cancelled via ctx.
+func FetchDataContext(ctx context.Context, endpoint string) (*SinaveData, error) {
+	resp, err := DefaultClient.Do(ctx, "POST", endpoint, "application/json; charset=UTF-8", nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("Error: %s", resp.Body)
+	}
+
+	var sdata *SinaveData
+	err = json.Unmarshal(resp.Body, &sdata)
+	if err != nil {
+		return nil, err
+	}
+	return sdata, nil
+}
+
+// FetchPastData gets a snapshot that was exported previously,
+// e.g. from the RepoURL mirror.
+func FetchPastData(endpoint string) (*SinaveData, error) {
+	return FetchPastDataContext(context.Background(), endpoint)
+}
+
+// FetchPastDataContext is like FetchPastData but can be cancelled via ctx.
+func FetchPastDataContext(ctx context.Context, endpoint string) (*SinaveData, error) {
+	resp, err := DefaultClient.Do(ctx, "GET", endpoint, "", nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == 404 {
+		return nil, fmt.Errorf("%s: %w", endpoint, ErrDataNotFound)
+	}
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("Error: %s", resp.Body)
+	}
+
+	sdata, err := DecodeSnapshot(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", endpoint, err)
+	}
+	return sdata, nil
+}
+
+// FetchPastMunicipiosContext gets a municipal level snapshot that was
+// exported previously, e.g. from the RepoURL mirror.
+func FetchPastMunicipiosContext(ctx context.Context, endpoint string) (map[string]Municipio, error) {
+	resp, err := DefaultClient.Do(ctx, "GET", endpoint, "", nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == 404 {
+		return nil, fmt.Errorf("%s: %w", endpoint, ErrDataNotFound)
+	}
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("Error: %s", resp.Body)
+	}
+
+	muns, err := DecodeMunicipalSnapshot(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", endpoint, err)
+	}
+	return muns, nil
+}
+
+// DetectLatestDataSource looks at the SINAVE map page to find out which
+// of the endpoints has the latest data.
+func DetectLatestDataSource() (string, error) {
+	return DetectLatestDataSourceContext(context.Background())
+}
+
+// DetectLatestDataSourceContext is like DetectLatestDataSource but can
+// be cancelled via ctx.
+func DetectLatestDataSourceContext(ctx context.Context) (string, error) {
+	resp, err := DefaultClient.Do(ctx, "GET", MapURL, "", nil)
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode != 200 {
+		return "", fmt.Errorf("Error: %s", resp.Body)
+	}
+	body := resp.Body
+
+	// ...
+	if bytes.Contains(body, []byte("Grafica22")) {
+		return URLA, nil
+	}
+	if bytes.Contains(body, []byte("Grafica23")) {
+		return URLB, nil
+	}
+	return "", ErrSourceNotFound
+}
@@ -0,0 +1,183 @@
+package sinave
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"sync"
+
+	"github.com/wallyqs/covid19mx/geo"
+)
+
+// FetchMunicipalData gets the number of cases of a given type
+// (e.g. "Confirmados", "Negativos", "Sospechosos" or "Defunciones")
+// keyed by the code of the municipio.
+func FetchMunicipalData(endpoint string, caseType string) (map[string]int, error) {
+	return FetchMunicipalDataContext(context.Background(), endpoint, caseType)
+}
+
+// FetchMunicipalDataContext is like FetchMunicipalData but can be
+// cancelled via ctx.
+func FetchMunicipalDataContext(ctx context.Context, endpoint string, caseType string) (map[string]int, error) {
+	vals := url.Values{"sPatType": {caseType}}
+	resp, err := DefaultClient.Do(ctx, "POST", endpoint, "application/x-www-form-urlencoded", []byte(vals.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("Error: %s", resp.Body)
+	}
+	muns, err := ParseScript(string(resp.Body))
+	if err != nil {
+		return nil, err
+	}
+
+	return muns, nil
+}
+
+// caseTypes are the case types published per municipio.
+var caseTypes = [4]string{"Confirmados", "Negativos", "Sospechosos", "Defunciones"}
+
+// MunicipalConcurrency is the maximum number of case types that are
+// fetched at the same time.
+var MunicipalConcurrency = 4
+
+// FetchMunicipios gets all the case types for every municipio keyed by
+// the code of the municipio.
+func FetchMunicipios(endpoint string) (map[string]Municipio, error) {
+	return FetchMunicipiosContext(context.Background(), endpoint)
+}
+
+// FetchMunicipiosContext is like FetchMunicipios but can be cancelled
+// via ctx. The case types are fetched in parallel and the first error
+// cancels the rest of the requests.
+func FetchMunicipiosContext(ctx context.Context, endpoint string) (map[string]Municipio, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+		results  = make([]map[string]int, len(caseTypes))
+		sem      = make(chan struct{}, MunicipalConcurrency)
+	)
+	for i, caseType := range caseTypes {
+		wg.Add(1)
+		go func(i int, caseType string) {
+			defer wg.Done()
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			case <-ctx.Done():
+				return
+			}
+			cases, err := FetchMunicipalDataContext(ctx, endpoint, caseType)
+			if err != nil {
+				mu.Lock()
+				if firstErr == nil {
+					firstErr = fmt.Errorf("%s: %w", caseType, err)
+					cancel()
+				}
+				mu.Unlock()
+				return
+			}
+			results[i] = cases
+		}(i, caseType)
+	}
+	wg.Wait()
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	pCases, nCases, sCases, dCases := results[0], results[1], results[2], results[3]
+
+	muns := make(map[string]Municipio)
+
+	// Collect positive, negative, suspect...
+	for k, v := range pCases {
+		muns[k] = Municipio{
+			Name:          geo.MunicipiosMexico[k].Name,
+			PositiveCases: v,
+		}
+	}
+	for k, v := range nCases {
+		m := muns[k]
+		m.NegativeCases = v
+		muns[k] = m
+	}
+	for k, v := range sCases {
+		m := muns[k]
+		m.SuspectCases = v
+		muns[k] = m
+	}
+	for k, v := range dCases {
+		m := muns[k]
+		m.Deaths = v
+		muns[k] = m
+	}
+	return muns, nil
+}
+
+// AssignUnknown moves the municipios with codes missing from
+// geo.MunicipiosMexico into the bucket given by geo.Bucket, and returns
+// the unknown codes sorted.
+func AssignUnknown(muns map[string]Municipio) (map[string]Municipio, []string) {
+	assigned := make(map[string]Municipio, len(muns))
+	unknown := make([]string, 0)
+	for code, m := range muns {
+		bucket := geo.Bucket(code)
+		if bucket != code {
+			unknown = append(unknown, code)
+		}
+		b := assigned[bucket]
+		b.Name = geo.MunicipioName(bucket)
+		b.PositiveCases += m.PositiveCases
+		b.NegativeCases += m.NegativeCases
+		b.SuspectCases += m.SuspectCases
+		b.Deaths += m.Deaths
+		assigned[bucket] = b
+	}
+	sort.Strings(unknown)
+	return assigned, unknown
+}
+
+// StatesFromMunicipios aggregates the municipal level data into state
+// level data, sorted by the code of the state. Unknown codes are counted
+// in the state of their bucket, see geo.Bucket.
+func StatesFromMunicipios(muns map[string]Municipio) *SinaveData {
+	states := make(map[string]State)
+	for code, m := range muns {
+		filter := geo.Bucket(code)[:2]
+		if s, ok := states[filter]; ok {
+			s.PositiveCases += m.PositiveCases
+			s.NegativeCases += m.NegativeCases
+			s.SuspectCases += m.SuspectCases
+			s.Deaths += m.Deaths
+			states[filter] = s
+		} else {
+			states[filter] = State{
+				Name:          geo.StateName(filter),
+				PositiveCases: m.PositiveCases,
+				NegativeCases: m.NegativeCases,
+				SuspectCases:  m.SuspectCases,
+				Deaths:        m.Deaths,
+			}
+		}
+	}
+	sdata := &SinaveData{
+		States: make([]State, 0),
+	}
+	codes := make([]string, 0, len(states))
+	for code := range states {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	for _, code := range codes {
+		sdata.States = append(sdata.States, states[code])
+	}
+	return sdata
+}
@@ -0,0 +1,102 @@
+package sinave
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// municipalServer answers like the MunicipalURL endpoint after the delay
+// of each sPatType, or fails with a 500 for the given case type.
+func municipalServer(t *testing.T, delays map[string]time.Duration, failing string) (*httptest.Server, *int32) {
+	t.Helper()
+	var cancelled int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		caseType := r.FormValue("sPatType")
+		select {
+		case <-time.After(delays[caseType]):
+		case <-r.Context().Done():
+			atomic.AddInt32(&cancelled, 1)
+			return
+		}
+		if caseType == failing {
+			http.Error(w, "failed", http.StatusInternalServerError)
+			return
+		}
+		fmt.Fprintf(w, "var m = new Array(); m['01001']=%d; $('body');", len(caseType))
+	}))
+	t.Cleanup(ts.Close)
+	return ts, &cancelled
+}
+
+// withClient replaces DefaultClient during a test.
+func withClient(t *testing.T, c *Client) {
+	t.Helper()
+	prev := DefaultClient
+	DefaultClient = c
+	t.Cleanup(func() { DefaultClient = prev })
+}
+
+func TestFetchMunicipiosConcurrently(t *testing.T) {
+	const delay = 200 * time.Millisecond
+	delays := make(map[string]time.Duration)
+	for _, caseType := range caseTypes {
+		delays[caseType] = delay
+	}
+	ts, _ := municipalServer(t, delays, "")
+	withClient(t, NewClient(5*time.Second, 0))
+
+	start := time.Now()
+	muns, err := FetchMunicipiosContext(context.Background(), ts.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if elapsed := time.Since(start); elapsed >= 2*delay {
+		t.Errorf("took %s, want about %s", elapsed, delay)
+	}
+
+	want := Municipio{
+		Name:          "Aguascalientes",
+		PositiveCases: len("Confirmados"),
+		NegativeCases: len("Negativos"),
+		SuspectCases:  len("Sospechosos"),
+		Deaths:        len("Defunciones"),
+	}
+	if got := muns["01001"]; got != want {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+}
+
+func TestFetchMunicipiosCancelsOnError(t *testing.T) {
+	delays := map[string]time.Duration{
+		"Confirmados": 5 * time.Second,
+		"Negativos":   5 * time.Second,
+		"Sospechosos": 5 * time.Second,
+		"Defunciones": 50 * time.Millisecond,
+	}
+	ts, cancelled := municipalServer(t, delays, "Defunciones")
+	withClient(t, NewClient(10*time.Second, 0))
+
+	start := time.Now()
+	_, err := FetchMunicipiosContext(context.Background(), ts.URL)
+	if err == nil || !strings.HasPrefix(err.Error(), "Defunciones:") {
+		t.Fatalf("got error %v, want the error of Defunciones", err)
+	}
+	if elapsed := time.Since(start); elapsed >= time.Second {
+		t.Errorf("took %s, the requests in flight were not cancelled", elapsed)
+	}
+
+	// The server notices the closed connections shortly after.
+	deadline := time.Now().Add(2 * time.Second)
+	for atomic.LoadInt32(cancelled) < 3 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if got := atomic.LoadInt32(cancelled); got != 3 {
+		t.Errorf("got %d cancelled requests, want 3", got)
+	}
+}
@@ -0,0 +1,60 @@
+package sinave
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"time"
+)
+
+// ErrNotRecorded is returned when replaying a request that was not
+// recorded.
+var ErrNotRecorded = errors.New("No recorded response for the request")
+
+// Recording is a directory with the raw responses from the endpoints,
+// so that a run can be replayed offline through the same parsing path.
+// Each response is kept in two files named after the request: the body
+// as it was received in <hash>.body, and the request, the headers and
+// the time it was fetched in <hash>.json.
+type Recording struct {
+	Dir string
+}
+
+// Save stores the response to a request, replacing any previous one.
+func (r *Recording) Save(method, endpoint string, body []byte, resp *Response) error {
+	path := entryPath(r.Dir, method, endpoint, body)
+	if err := writeFile(r.Dir, bodyPath(path), resp.Body); err != nil {
+		return err
+	}
+	return writeEntry(r.Dir, &CacheEntry{
+		Method:     method,
+		URL:        endpoint,
+		Request:    string(body),
+		FetchedAt:  time.Now().UTC(),
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header,
+	})
+}
+
+// Load returns the recorded response to a request.
+func (r *Recording) Load(method, endpoint string, body []byte) (*Response, error) {
+	entry, err := readEntry(entryPath(r.Dir, method, endpoint, body))
+	if os.IsNotExist(err) {
+		return nil, fmt.Errorf("%s %s: %w", method, endpoint, ErrNotRecorded)
+	}
+	if err != nil {
+		return nil, err
+	}
+	b, err := ioutil.ReadFile(bodyPath(entry.Path))
+	if err != nil {
+		return nil, err
+	}
+	return &Response{StatusCode: entry.StatusCode, Header: entry.Header, Body: b}, nil
+}
+
+// bodyPath returns the file with the body of the response of an entry.
+func bodyPath(path string) string {
+	return strings.TrimSuffix(path, ".json") + ".body"
+}
@@ -0,0 +1,106 @@
+package sinave
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"testing"
+)
+
+func TestRecordingKeepsRawBody(t *testing.T) {
+	r := &Recording{Dir: t.TempDir()}
+	form := []byte("sPatType=Confirmados")
+	resp := &Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": {"text/html"}},
+		Body:       []byte("var m = new Array(); m['01001']=3;"),
+	}
+	if err := r.Save("POST", MunicipalURL, form, resp); err != nil {
+		t.Fatal(err)
+	}
+
+	bodies, err := filepath.Glob(filepath.Join(r.Dir, "*.body"))
+	if err != nil || len(bodies) != 1 {
+		t.Fatalf("got body files %v (%v), want one", bodies, err)
+	}
+	raw, err := ioutil.ReadFile(bodies[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(raw) != string(resp.Body) {
+		t.Errorf("got body file %q, want %q", raw, resp.Body)
+	}
+
+	got, err := r.Load("POST", MunicipalURL, form)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.StatusCode != resp.StatusCode || string(got.Body) != string(resp.Body) ||
+		got.Header.Get("Content-Type") != "text/html" {
+		t.Errorf("got %d %v %q, want the saved response", got.StatusCode, got.Header, got.Body)
+	}
+
+	_, err = r.Load("POST", MunicipalURL, []byte("sPatType=Negativos"))
+	if !errors.Is(err, ErrNotRecorded) {
+		t.Errorf("got error %v, want %v", err, ErrNotRecorded)
+	}
+}
+
+func TestReplayParsesRecordedResponses(t *testing.T) {
+	r := &Recording{Dir: t.TempDir()}
+	grafica := []byte(`{"d":"[[1,\"Aguascalientes\",1353758.409,\"01\",24,243,74,0,1.77],` +
+		`[33,\"NACIONAL\",0,\"00\",100,200,30,4,0.8]]"}`)
+	if err := r.Save("POST", AttackRateURL, nil, &Response{StatusCode: http.StatusOK, Body: grafica}); err != nil {
+		t.Fatal(err)
+	}
+	confirmados, err := ioutil.ReadFile(filepath.Join("testdata", "getinfomun.js"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, caseType := range caseTypes {
+		body := []byte("var m = new Array(); m['01001']=" + fmt.Sprint(len(caseType)) + ";")
+		if caseType == "Confirmados" {
+			body = confirmados
+		}
+		form := []byte(url.Values{"sPatType": {caseType}}.Encode())
+		if err := r.Save("POST", MunicipalURL, form, &Response{StatusCode: http.StatusOK, Body: body}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	withClient(t, &Client{Replay: r})
+
+	sdata, err := FetchData(AttackRateURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := State{Name: "Aguascalientes", PositiveCases: 24, NegativeCases: 243, SuspectCases: 74, AttackRate: 1.77}
+	if len(sdata.States) != 1 || sdata.States[0] != want {
+		t.Errorf("got states %+v, want %+v", sdata.States, want)
+	}
+
+	muns, err := FetchMunicipios(MunicipalURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantMun := Municipio{
+		Name:          "Aguascalientes",
+		PositiveCases: 948,
+		NegativeCases: len("Negativos"),
+		SuspectCases:  len("Sospechosos"),
+		Deaths:        len("Defunciones"),
+	}
+	if got := muns["01001"]; got != wantMun {
+		t.Errorf("got %+v, want %+v", got, wantMun)
+	}
+	if got := muns["14039"].PositiveCases; got != 8321 {
+		t.Errorf("got %d positive cases in 14039, want 8321", got)
+	}
+
+	// Nothing else was recorded.
+	if _, err := FetchData(URLA); !errors.Is(err, ErrNotRecorded) {
+		t.Errorf("got error %v, want %v", err, ErrNotRecorded)
+	}
+}
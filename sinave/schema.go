@@ -0,0 +1,185 @@
+package sinave
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrPayload       = errors.New("Unexpected payload")
+	ErrMissingColumn = errors.New("missing column")
+	ErrInvalidCell   = errors.New("invalid cell")
+)
+
+// CellKind is the type of the value expected in a column.
+type CellKind int
+
+const (
+	StringCell CellKind = iota
+	IntCell
+	FloatCell
+)
+
+func (k CellKind) String() string {
+	switch k {
+	case IntCell:
+		return "integer"
+	case FloatCell:
+		return "number"
+	}
+	return "string"
+}
+
+// Column is one of the columns of the rows sent by the SINAVE endpoints
+// that is used, identified by its position.
+type Column struct {
+	Index int
+	Name  string
+	Kind  CellKind
+}
+
+// Schema are the columns of each row of the SINAVE payload, e.g.
+//
+//	[1 Aguascalientes 1353758.409 01 24 243 74 0 1.77]
+var Schema = []Column{
+	{1, "name", StringCell},
+	{4, "positive", IntCell},
+	{5, "negative", IntCell},
+	{6, "suspect", IntCell},
+	{7, "deaths", IntCell},
+	{8, "attack_rate", FloatCell},
+}
+
+// RowError is an error in a cell of the SINAVE payload, the row and
+// column are numbered from 0.
+type RowError struct {
+	Row    int
+	Column Column
+	Err    error
+}
+
+func (e *RowError) Error() string {
+	return fmt.Sprintf("row %d, column %d (%s): %s", e.Row, e.Column.Index, e.Column.Name, e.Err)
+}
+
+func (e *RowError) Unwrap() error {
+	return e.Err
+}
+
+// DecodeRows extracts the rows from the payload returned by the SINAVE
+// endpoints, which embeds them as a JSON string: {"d":"[[...]]"}
+func DecodeRows(b []byte) ([][]interface{}, error) {
+	var all map[string]json.RawMessage
+	if err := json.Unmarshal(b, &all); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrPayload, err)
+	}
+	d, ok := all["d"]
+	if !ok {
+		return nil, fmt.Errorf("%w: missing the \"d\" field", ErrPayload)
+	}
+
+	// Tolerate the rows not being embedded as a string.
+	var data string
+	if err := json.Unmarshal(d, &data); err == nil {
+		d = []byte(data)
+	}
+	var rows [][]interface{}
+	dec := json.NewDecoder(bytes.NewReader(d))
+	dec.UseNumber()
+	if err := dec.Decode(&rows); err != nil {
+		return nil, fmt.Errorf("%w: the \"d\" field is not a list of rows: %s", ErrPayload, err)
+	}
+	return rows, nil
+}
+
+// DecodeState maps the cells of a row to a state using the Schema.
+func DecodeState(i int, row []interface{}) (State, error) {
+	var state State
+	for _, col := range Schema {
+		if col.Index >= len(row) {
+			return state, &RowError{i, col, fmt.Errorf("%w, the row has %d columns", ErrMissingColumn, len(row))}
+		}
+		cell := row[col.Index]
+		var err error
+		switch col.Name {
+		case "name":
+			state.Name, err = cellString(cell)
+		case "positive":
+			state.PositiveCases, err = cellInt(cell)
+		case "negative":
+			state.NegativeCases, err = cellInt(cell)
+		case "suspect":
+			state.SuspectCases, err = cellInt(cell)
+		case "deaths":
+			state.Deaths, err = cellInt(cell)
+		case "attack_rate":
+			state.AttackRate, err = cellFloat(cell)
+		}
+		if err != nil {
+			return state, &RowError{i, col, err}
+		}
+	}
+	return state, nil
+}
+
+// Validate checks every row of a payload against the Schema and returns
+// the number of rows along with all the errors found.
+func Validate(b []byte) (int, []error) {
+	rows, err := DecodeRows(b)
+	if err != nil {
+		return 0, []error{err}
+	}
+	errs := make([]error, 0)
+	if len(rows) == 0 {
+		errs = append(errs, fmt.Errorf("%w: no rows", ErrPayload))
+	}
+	for i, row := range rows {
+		if _, err := DecodeState(i, row); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return len(rows), errs
+}
+
+// cellString accepts strings and numbers.
+func cellString(v interface{}) (string, error) {
+	switch c := v.(type) {
+	case string:
+		return c, nil
+	case json.Number:
+		return c.String(), nil
+	}
+	return "", fmt.Errorf("%w: expected %s, found %v", ErrInvalidCell, StringCell, v)
+}
+
+// cellInt accepts integers either as numbers or as strings.
+func cellInt(v interface{}) (int, error) {
+	f, err := cellFloat(v)
+	if err != nil || f != math.Trunc(f) {
+		return 0, fmt.Errorf("%w: expected %s, found %v", ErrInvalidCell, IntCell, v)
+	}
+	return int(f), nil
+}
+
+// cellFloat accepts numbers either as numbers or as strings.
+func cellFloat(v interface{}) (float64, error) {
+	var s string
+	switch c := v.(type) {
+	case string:
+		s = strings.TrimSpace(c)
+	case json.Number:
+		s = c.String()
+	default:
+		return 0, fmt.Errorf("%w: expected %s, found %v", ErrInvalidCell, FloatCell, v)
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil || math.IsNaN(f) || math.IsInf(f, 0) {
+		return 0, fmt.Errorf("%w: expected %s, found %q", ErrInvalidCell, FloatCell, s)
+	}
+	return f, nil
+}
@@ -0,0 +1,144 @@
+package sinave
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestDecodeRows(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		payload string
+		rows    int
+		err     error
+	}{
+		{"embedded string", `{"d":"[[1,\"Aguascalientes\"],[2,\"Baja California\"]]"}`, 2, nil},
+		{"plain list", `{"d":[[1,"Aguascalientes"]]}`, 1, nil},
+		{"empty", `{"d":"[]"}`, 0, nil},
+		{"missing d", `{"e":"[]"}`, 0, ErrPayload},
+		{"not json", `<html>`, 0, ErrPayload},
+		{"not rows", `{"d":"{\"a\":1}"}`, 0, ErrPayload},
+		{"not a list", `{"d":42}`, 0, ErrPayload},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			rows, err := DecodeRows([]byte(tt.payload))
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("got error %v, want %v", err, tt.err)
+			}
+			if len(rows) != tt.rows {
+				t.Errorf("got %d rows, want %d", len(rows), tt.rows)
+			}
+		})
+	}
+}
+
+func TestDecodeState(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		row    string
+		want   State
+		column string
+		err    error
+	}{
+		{
+			name: "numbers",
+			row:  `[1, "Aguascalientes", 1353758.409, "01", 24, 243, 74, 0, 1.77]`,
+			want: State{Name: "Aguascalientes", PositiveCases: 24, NegativeCases: 243, SuspectCases: 74, AttackRate: 1.77},
+		},
+		{
+			name: "strings",
+			row:  `["1", "Aguascalientes", "1353758.409", "01", "24", " 243 ", "74", "0", "1.77"]`,
+			want: State{Name: "Aguascalientes", PositiveCases: 24, NegativeCases: 243, SuspectCases: 74, AttackRate: 1.77},
+		},
+		{
+			name: "integral floats",
+			row:  `[1, "Aguascalientes", 0, "01", 24.0, 2.43e2, 74, 0, 2]`,
+			want: State{Name: "Aguascalientes", PositiveCases: 24, NegativeCases: 243, SuspectCases: 74, AttackRate: 2},
+		},
+		{
+			name: "numeric name",
+			row:  `[1, 33, 0, "01", 1, 2, 3, 4, 5]`,
+			want: State{Name: "33", PositiveCases: 1, NegativeCases: 2, SuspectCases: 3, Deaths: 4, AttackRate: 5},
+		},
+		{name: "short row", row: `[1, "Aguascalientes", 0, "01", 24, 243, 74]`, column: "deaths", err: ErrMissingColumn},
+		{name: "empty row", row: `[]`, column: "name", err: ErrMissingColumn},
+		{name: "fractional count", row: `[1, "Aguascalientes", 0, "01", 24.5, 243, 74, 0, 1.77]`, column: "positive", err: ErrInvalidCell},
+		{name: "text count", row: `[1, "Aguascalientes", 0, "01", 24, "n/a", 74, 0, 1.77]`, column: "negative", err: ErrInvalidCell},
+		{name: "null count", row: `[1, "Aguascalientes", 0, "01", 24, 243, null, 0, 1.77]`, column: "suspect", err: ErrInvalidCell},
+		{name: "null name", row: `[1, null, 0, "01", 24, 243, 74, 0, 1.77]`, column: "name", err: ErrInvalidCell},
+		{name: "text rate", row: `[1, "Aguascalientes", 0, "01", 24, 243, 74, 0, "NaN"]`, column: "attack_rate", err: ErrInvalidCell},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			rows, err := DecodeRows([]byte(`{"d":[` + tt.row + `]}`))
+			if err != nil {
+				t.Fatal(err)
+			}
+			state, err := DecodeState(7, rows[0])
+			if tt.err == nil {
+				if err != nil {
+					t.Fatal(err)
+				}
+				if state != tt.want {
+					t.Errorf("got %+v, want %+v", state, tt.want)
+				}
+				return
+			}
+
+			var rerr *RowError
+			if !errors.As(err, &rerr) {
+				t.Fatalf("got error %v, want a *RowError", err)
+			}
+			if !errors.Is(err, tt.err) {
+				t.Errorf("got error %v, want %v", err, tt.err)
+			}
+			if rerr.Row != 7 || rerr.Column.Name != tt.column {
+				t.Errorf("got the error in row %d, column %s, want row 7, column %s", rerr.Row, rerr.Column.Name, tt.column)
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	payload := `{"d":"[[1,\"Aguascalientes\",0,\"01\",24,243,74,0,1.77],` +
+		`[2,\"Baja California\",0,\"02\",27,\"x\",135,0,0.8],` +
+		`[3,\"Baja California Sur\",0,\"03\"]]"}`
+	n, errs := Validate([]byte(payload))
+	if n != 3 {
+		t.Errorf("got %d rows, want 3", n)
+	}
+	want := []struct {
+		row    int
+		column string
+	}{{1, "negative"}, {2, "positive"}}
+	if len(errs) != len(want) {
+		t.Fatalf("got errors %v, want %d", errs, len(want))
+	}
+	for i, err := range errs {
+		var rerr *RowError
+		if !errors.As(err, &rerr) || rerr.Row != want[i].row || rerr.Column.Name != want[i].column {
+			t.Errorf("got error %v, want row %d, column %s", err, want[i].row, want[i].column)
+		}
+	}
+
+	if _, errs := Validate([]byte(`{"d":"[]"}`)); len(errs) != 1 || !errors.Is(errs[0], ErrPayload) {
+		t.Errorf("got errors %v, want one about the missing rows", errs)
+	}
+}
+
+func TestUnmarshalSkipsNational(t *testing.T) {
+	payload := `{"d":"[[1,\"Aguascalientes\",0,\"01\",24,243,74,0,1.77],[33,\"NACIONAL\"]]"}`
+	var sdata SinaveData
+	if err := json.Unmarshal([]byte(payload), &sdata); err != nil {
+		t.Fatal(err)
+	}
+	if len(sdata.States) != 1 || sdata.States[0].Name != "Aguascalientes" {
+		t.Errorf("got states %+v, want only Aguascalientes", sdata.States)
+	}
+
+	err := json.Unmarshal([]byte(`{"d":"[[1,\"Aguascalientes\",0,\"01\",24]]"}`), &sdata)
+	var rerr *RowError
+	if !errors.As(err, &rerr) || rerr.Row != 0 || rerr.Column.Name != "negative" {
+		t.Errorf("got error %v, want a *RowError in row 0, column negative", err)
+	}
+}
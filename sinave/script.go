@@ -0,0 +1,344 @@
+package sinave
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// ParseError is an error in the javascript returned by the MunicipalURL
+// endpoint, at the given byte offset.
+type ParseError struct {
+	Offset int
+	Msg    string
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("script: offset %d: %s", e.Offset, e.Msg)
+}
+
+// ParseScript extracts the number of cases per municipio from the
+// javascript returned by the MunicipalURL endpoint, which assigns them
+// one by one to an array keyed by the code of the municipio:
+//
+//	var muns = new Array();
+//	muns['01001'] = 1234;
+//	muns["01002"] = 56.0;
+//
+// The value can also be an array literal, in which case the first
+// element is the number of cases. Numbers with a fractional part are
+// reported as a *ParseError instead of being rounded. Any other statement is skipped and
+// parsing stops at the first reference to 'body', after which the page
+// is not data anymore.
+func ParseScript(sample string) (map[string]int, error) {
+	p := &scriptParser{lex: &scriptLexer{src: sample}}
+	muns := map[string]int{}
+	for {
+		tok, err := p.next()
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case tok.kind == tokEOF:
+			return muns, nil
+		case tok.kind == tokString && tok.text == "body":
+			return muns, nil
+		case tok.kind == tokIdent && tok.text != "var" && tok.text != "new":
+			code, v, ok, err := p.assignment()
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				muns[code] = v
+			}
+		}
+	}
+}
+
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	tokIdent
+	tokString
+	tokNumber
+	tokPunct
+)
+
+func (k tokenKind) String() string {
+	switch k {
+	case tokEOF:
+		return "end of input"
+	case tokIdent:
+		return "identifier"
+	case tokString:
+		return "string"
+	case tokNumber:
+		return "number"
+	}
+	return "punctuation"
+}
+
+type token struct {
+	kind   tokenKind
+	text   string
+	offset int
+}
+
+func (t token) String() string {
+	if t.kind == tokEOF {
+		return t.kind.String()
+	}
+	return fmt.Sprintf("%s %q", t.kind, t.text)
+}
+
+// scriptParser reads the tokens of the script and allows looking at
+// the next one without consuming it.
+type scriptParser struct {
+	lex    *scriptLexer
+	peeked *token
+}
+
+func (p *scriptParser) next() (token, error) {
+	if p.peeked != nil {
+		tok := *p.peeked
+		p.peeked = nil
+		return tok, nil
+	}
+	return p.lex.next()
+}
+
+func (p *scriptParser) peek() (token, error) {
+	if p.peeked == nil {
+		tok, err := p.lex.next()
+		if err != nil {
+			return tok, err
+		}
+		p.peeked = &tok
+	}
+	return *p.peeked, nil
+}
+
+// accept consumes the next token if it is the given punctuation.
+func (p *scriptParser) accept(punct string) (bool, error) {
+	tok, err := p.peek()
+	if err != nil {
+		return false, err
+	}
+	if tok.kind != tokPunct || tok.text != punct {
+		return false, nil
+	}
+	p.peeked = nil
+	return true, nil
+}
+
+// expect consumes the next token, which has to be of the given kind, or
+// the given punctuation when it is not empty.
+func (p *scriptParser) expect(kind tokenKind, punct string) (token, error) {
+	tok, err := p.next()
+	if err != nil {
+		return tok, err
+	}
+	if tok.kind != kind || (punct != "" && tok.text != punct) {
+		want := kind.String()
+		if punct != "" {
+			want = fmt.Sprintf("%q", punct)
+		}
+		return tok, &ParseError{tok.offset, fmt.Sprintf("expected %s, found %s", want, tok)}
+	}
+	return tok, nil
+}
+
+// assignment parses the rest of a statement that started with an
+// identifier, which only has data when it is of the form:
+//
+//	ident['code'] = value;
+//
+// Anything else, e.g. `var muns = new Array();`, is skipped.
+func (p *scriptParser) assignment() (string, int, bool, error) {
+	ok, err := p.accept("[")
+	if err != nil || !ok {
+		return "", 0, false, err
+	}
+	key, err := p.next()
+	if err != nil {
+		return "", 0, false, err
+	}
+	if key.kind != tokString {
+		// Indexed by a variable or a number, not a municipio.
+		return "", 0, false, nil
+	}
+	if key.text == "body" {
+		p.peeked = &key
+		return "", 0, false, nil
+	}
+	if _, err := p.expect(tokPunct, "]"); err != nil {
+		return "", 0, false, err
+	}
+	ok, err = p.accept("=")
+	if err != nil || !ok {
+		return "", 0, false, err
+	}
+	tok, err := p.peek()
+	if err != nil {
+		return "", 0, false, err
+	}
+	if tok.kind == tokIdent && tok.text == "new" {
+		// Not a number, e.g. `muns['01001'] = new Array();`
+		return "", 0, false, nil
+	}
+	v, err := p.value()
+	if err != nil {
+		return "", 0, false, err
+	}
+	if _, err := p.accept(";"); err != nil {
+		return "", 0, false, err
+	}
+	return key.text, v, true, nil
+}
+
+// value parses a number, or an array literal with a number as its first
+// element.
+func (p *scriptParser) value() (int, error) {
+	ok, err := p.accept("[")
+	if err != nil {
+		return 0, err
+	}
+	if !ok {
+		return p.number()
+	}
+	v, err := p.number()
+	if err != nil {
+		return 0, err
+	}
+	for {
+		tok, err := p.next()
+		if err != nil {
+			return 0, err
+		}
+		switch {
+		case tok.kind == tokPunct && tok.text == "]":
+			return v, nil
+		case tok.kind == tokEOF:
+			return 0, &ParseError{tok.offset, "unterminated array"}
+		}
+	}
+}
+
+func (p *scriptParser) number() (int, error) {
+	tok, err := p.expect(tokNumber, "")
+	if err != nil {
+		return 0, err
+	}
+	f, err := strconv.ParseFloat(tok.text, 64)
+	if err != nil {
+		return 0, &ParseError{tok.offset, fmt.Sprintf("invalid number %q", tok.text)}
+	}
+	if f < 0 || f > math.MaxInt32 {
+		return 0, &ParseError{tok.offset, fmt.Sprintf("number of cases out of range: %s", tok.text)}
+	}
+	if f != math.Trunc(f) {
+		return 0, &ParseError{tok.offset, fmt.Sprintf("fractional number of cases: %s", tok.text)}
+	}
+	return int(f), nil
+}
+
+// scriptLexer splits the javascript into identifiers, strings, numbers
+// and punctuation, skipping whitespace and comments.
+type scriptLexer struct {
+	src string
+	pos int
+}
+
+func (l *scriptLexer) next() (token, error) {
+	l.skip()
+	if l.pos >= len(l.src) {
+		return token{kind: tokEOF, offset: len(l.src)}, nil
+	}
+	start := l.pos
+	c := l.src[l.pos]
+	switch {
+	case c == '\'' || c == '"':
+		return l.string(c)
+	case isDigit(c) || ((c == '-' || c == '.') && l.pos+1 < len(l.src) && isDigit(l.src[l.pos+1])):
+		l.pos++
+		for l.pos < len(l.src) && (isDigit(l.src[l.pos]) || strings.IndexByte(".eE", l.src[l.pos]) >= 0 ||
+			((l.src[l.pos] == '-' || l.src[l.pos] == '+') && strings.IndexByte("eE", l.src[l.pos-1]) >= 0)) {
+			l.pos++
+		}
+		return token{tokNumber, l.src[start:l.pos], start}, nil
+	case isIdentStart(c):
+		for l.pos < len(l.src) && (isIdentStart(l.src[l.pos]) || isDigit(l.src[l.pos])) {
+			l.pos++
+		}
+		return token{tokIdent, l.src[start:l.pos], start}, nil
+	}
+	l.pos++
+	return token{tokPunct, l.src[start:l.pos], start}, nil
+}
+
+// string reads a quoted string, unescaping the common escape sequences.
+func (l *scriptLexer) string(quote byte) (token, error) {
+	start := l.pos
+	l.pos++
+	var sb strings.Builder
+	for l.pos < len(l.src) {
+		c := l.src[l.pos]
+		switch {
+		case c == quote:
+			l.pos++
+			return token{tokString, sb.String(), start}, nil
+		case c == '\n':
+			return token{}, &ParseError{start, "unterminated string"}
+		case c == '\\' && l.pos+1 < len(l.src):
+			l.pos++
+			switch e := l.src[l.pos]; e {
+			case 'n':
+				sb.WriteByte('\n')
+			case 't':
+				sb.WriteByte('\t')
+			default:
+				sb.WriteByte(e)
+			}
+		default:
+			sb.WriteByte(c)
+		}
+		l.pos++
+	}
+	return token{}, &ParseError{start, "unterminated string"}
+}
+
+// skip moves past whitespace and comments.
+func (l *scriptLexer) skip() {
+	for l.pos < len(l.src) {
+		switch {
+		case strings.IndexByte(" \t\r\n\f\v", l.src[l.pos]) >= 0:
+			l.pos++
+		case strings.HasPrefix(l.src[l.pos:], "//"):
+			end := strings.IndexByte(l.src[l.pos:], '\n')
+			if end < 0 {
+				l.pos = len(l.src)
+				return
+			}
+			l.pos += end + 1
+		case strings.HasPrefix(l.src[l.pos:], "/*"):
+			end := strings.Index(l.src[l.pos+2:], "*/")
+			if end < 0 {
+				l.pos = len(l.src)
+				return
+			}
+			l.pos += end + 4
+		default:
+			return
+		}
+	}
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isIdentStart(c byte) bool {
+	return c == '_' || c == '$' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
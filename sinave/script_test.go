@@ -0,0 +1,94 @@
+package sinave
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "Update the golden files in testdata")
+
+// TestParseScriptGolden parses each testdata/*.js and compares the
+// municipios, or the error, with the .golden file next to it.
+func TestParseScriptGolden(t *testing.T) {
+	files, err := filepath.Glob(filepath.Join("testdata", "*.js"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) == 0 {
+		t.Fatal("no testdata/*.js files")
+	}
+	for _, file := range files {
+		t.Run(filepath.Base(file), func(t *testing.T) {
+			src, err := ioutil.ReadFile(file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []byte
+			muns, err := ParseScript(string(src))
+			if err != nil {
+				var perr *ParseError
+				if !errors.As(err, &perr) {
+					t.Fatalf("got %T, want *ParseError", err)
+				}
+				got = []byte("error: " + err.Error() + "\n")
+			} else {
+				got, err = json.MarshalIndent(muns, "", "  ")
+				if err != nil {
+					t.Fatal(err)
+				}
+				got = append(got, '\n')
+			}
+
+			golden := strings.TrimSuffix(file, ".js") + ".golden"
+			if *update {
+				if err := ioutil.WriteFile(golden, got, 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			want, err := ioutil.ReadFile(golden)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != string(want) {
+				t.Errorf("got:\n%s\nwant:\n%s", got, want)
+			}
+		})
+	}
+}
+
+func FuzzParseScript(f *testing.F) {
+	files, err := filepath.Glob(filepath.Join("testdata", "*.js"))
+	if err != nil {
+		f.Fatal(err)
+	}
+	for _, file := range files {
+		src, err := ioutil.ReadFile(file)
+		if err != nil {
+			f.Fatal(err)
+		}
+		f.Add(string(src))
+	}
+	f.Fuzz(func(t *testing.T, src string) {
+		muns, err := ParseScript(src)
+		if err != nil {
+			var perr *ParseError
+			if !errors.As(err, &perr) {
+				t.Fatalf("got %T, want *ParseError", err)
+			}
+			if perr.Offset < 0 || perr.Offset > len(src) {
+				t.Fatalf("offset %d out of the input of length %d", perr.Offset, len(src))
+			}
+			return
+		}
+		for code, v := range muns {
+			if v < 0 {
+				t.Fatalf("negative number of cases %d for %q", v, code)
+			}
+		}
+	})
+}
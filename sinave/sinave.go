@@ -0,0 +1,194 @@
+// Package sinave fetches and parses the COVID19 data published by the
+// Mexican government at https://covid19.sinave.gob.mx and
+// https://coronavirus.gob.mx.
+package sinave
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+const (
+	// MapURL is used to detect where the actual data is being located,
+	// this seems to change day to day.
+	MapURL = "https://covid19.sinave.gob.mx/mapa.aspx"
+
+	// Latest data will be usually found in one of the following urls.
+	URLA = "https://covid19.sinave.gob.mx/Mapa.aspx/Grafica22"
+	URLB = "https://covid19.sinave.gob.mx/Mapa.aspx/Grafica23"
+
+	// RepoURL can be used to fetch previous days date.
+	RepoURL = "https://wallyqs.github.io/covid19mx/data/"
+
+	// AttackRateURL is the url with the info with the
+	//
+	// See: https://en.wikipedia.org/wiki/Attack_rate
+	//      https://es.wikipedia.org/wiki/Incidencia
+	//
+	AttackRateURL = "https://covid19.sinave.gob.mx/Mapatasas.aspx/Grafica22"
+
+	// MunicipalURL is the url from where we can get the data at a
+	// municipal level.
+	MunicipalURL = "https://coronavirus.gob.mx/fHDMap/info/getInfoMun.php"
+)
+
+var (
+	ErrSourceNotFound = errors.New("Could not find datasource!")
+	ErrDataNotFound   = errors.New("Could not find data for the requested date!")
+)
+
+// State has the number of cases reported for a single state.
+type State struct {
+	Name          string  `json:"name"`
+	PositiveCases int     `json:"positive"`
+	NegativeCases int     `json:"negative"`
+	SuspectCases  int     `json:"suspect"`
+	Deaths        int     `json:"deaths"`
+	AttackRate    float64 `json:"attack_rate"`
+}
+
+// Municipio has the number of cases reported for a single municipio.
+type Municipio struct {
+	Name          string  `json:"name"`
+	PositiveCases int     `json:"positive"`
+	NegativeCases int     `json:"negative"`
+	SuspectCases  int     `json:"suspect"`
+	Deaths        int     `json:"deaths"`
+	AttackRate    float64 `json:"attack_rate"`
+}
+
+// SinaveData is the state level data for a single day.
+type SinaveData struct {
+	States []State `json:"states"`
+	tpc    int
+	tnc    int
+	tsc    int
+	td     int
+
+	// ar is the attackRate
+	ar float64
+
+	// Partial is set when some of the states were left out, e.g. by
+	// filtering them, so the totals are not the national ones.
+	Partial bool `json:"partial,omitempty"`
+}
+
+// UnmarshalJSON decodes the payload returned by the SINAVE endpoints,
+// the errors in the rows are reported as a *RowError.
+func (s *SinaveData) UnmarshalJSON(b []byte) error {
+	rows, err := DecodeRows(b)
+	if err != nil {
+		return err
+	}
+
+	s.States = make([]State, 0)
+	for i, row := range rows {
+		if len(row) > 1 {
+			if name, _ := cellString(row[1]); name == "NACIONAL" {
+				continue
+			}
+		}
+		state, err := DecodeState(i, row)
+		if err != nil {
+			return err
+		}
+		s.States = append(s.States, state)
+	}
+
+	return nil
+}
+
+// DecodeSnapshot decodes the data as exported by `covid19mx -o json`,
+// which is the format used by the files from the data/ directory.
+func DecodeSnapshot(b []byte) (*SinaveData, error) {
+	type s struct {
+		States []State `json:"states"`
+	}
+	var sd *s
+	err := json.Unmarshal(b, &sd)
+	if err != nil {
+		return nil, err
+	}
+	sdata := &SinaveData{
+		States: sd.States,
+	}
+	return sdata, nil
+}
+
+// MunicipalSnapshot is the format of the municipal level data stored in
+// the data/ directory, keyed by the INEGI code of the municipio.
+type MunicipalSnapshot struct {
+	Municipios map[string]Municipio `json:"municipios"`
+}
+
+// DecodeMunicipalSnapshot decodes the municipal level data from the
+// data/ directory.
+func DecodeMunicipalSnapshot(b []byte) (map[string]Municipio, error) {
+	var ms MunicipalSnapshot
+	err := json.Unmarshal(b, &ms)
+	if err != nil {
+		return nil, err
+	}
+	if ms.Municipios == nil {
+		return nil, errors.New("Missing the municipios in the snapshot")
+	}
+	return ms.Municipios, nil
+}
+
+// TotalName is the name of the row with the totals, "SUBTOTAL" when the
+// data is Partial.
+func (sdata *SinaveData) TotalName() string {
+	if sdata.Partial {
+		return "SUBTOTAL"
+	}
+	return "TOTAL"
+}
+
+// TotalPositiveCases returns the number of positive cases in the country.
+func (sdata *SinaveData) TotalPositiveCases() int {
+	if sdata.tpc > 0 {
+		return sdata.tpc
+	}
+	for _, state := range sdata.States {
+		sdata.tpc += state.PositiveCases
+	}
+	return sdata.tpc
+}
+
+// TotalNegativeCases returns the number of negative cases in the country.
+func (sdata *SinaveData) TotalNegativeCases() int {
+	if sdata.tnc > 0 {
+		return sdata.tnc
+	}
+	for _, state := range sdata.States {
+		sdata.tnc += state.NegativeCases
+	}
+	return sdata.tnc
+}
+
+// TotalSuspectCases returns the number of suspect cases in the country.
+func (sdata *SinaveData) TotalSuspectCases() int {
+	if sdata.tsc > 0 {
+		return sdata.tsc
+	}
+	for _, state := range sdata.States {
+		sdata.tsc += state.SuspectCases
+	}
+	return sdata.tsc
+}
+
+// TotalDeaths returns the number of deaths in the country.
+func (sdata *SinaveData) TotalDeaths() int {
+	if sdata.td > 0 {
+		return sdata.td
+	}
+	for _, state := range sdata.States {
+		sdata.td += state.Deaths
+	}
+	return sdata.td
+}
+
+// TestPositivityRate returns the ratio of positive tests nationwide.
+func (sdata *SinaveData) TestPositivityRate() float64 {
+	return float64(sdata.TotalPositiveCases()) / float64((sdata.TotalPositiveCases() + sdata.TotalNegativeCases()))
+}
@@ -0,0 +1,2 @@
+muns['01001'] = 12;
+muns['01002'] = 7.5;
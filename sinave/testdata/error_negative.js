@@ -0,0 +1 @@
+muns['01001'] = -4;
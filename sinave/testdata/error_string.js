@@ -0,0 +1,2 @@
+muns['01001'] = 10;
+muns['01002 = 4;
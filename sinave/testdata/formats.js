@@ -0,0 +1,13 @@
+// Values written in the different ways the endpoint has used.
+var muns = new Array();
+muns['01001'] = 10;
+muns["01002"] = 56.0;
+muns['01003'] = 1e3;
+muns['01004'] = [7, 'Calvillo', 0.5];
+muns['01005'] = new Array();
+muns[i] = 42;
+/* muns['01006'] = 99; */
+muns['01007'] = 3
+muns['01008'] = 4;
+$('body');
+muns['01009'] = 5;
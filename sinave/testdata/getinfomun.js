@@ -0,0 +1,13 @@
+<script type="text/javascript">
+	var aMun = new Array();
+	aMun['01001']=948;
+	aMun['01002']=12;
+	aMun['01005']=0;
+	aMun['09007']=15234;
+	aMun['14039']=8321;
+	aMun['32056']=7;
+	aMun['99999']=3;
+	$(document).ready(function () {
+		$('body').append('<div id="mapa"></div>');
+	});
+</script>
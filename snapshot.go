@@ -0,0 +1,93 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/wallyqs/covid19mx/archive"
+	"github.com/wallyqs/covid19mx/sinave"
+	"github.com/wallyqs/covid19mx/source"
+)
+
+// runSnapshot fetches the latest data and stores it into the archive.
+func runSnapshot(args []string) error {
+	var (
+		dir     string
+		uri     string
+		day     string
+		force   bool
+		withCSV bool
+		withMun bool
+	)
+	fs := flag.NewFlagSet("snapshot", flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Printf("Usage: covid19mx snapshot [options...]\n\n")
+		fs.PrintDefaults()
+		fmt.Println()
+	}
+	fs.StringVar(&dir, "dir", "data", "Directory of the archive")
+	fs.StringVar(&uri, "source", "sinave://", "Source of the data")
+	fs.StringVar(&day, "date", "", "Date of the snapshot (default today)")
+	fs.BoolVar(&force, "force", false, "Overwrite an existing snapshot with different data")
+	fs.BoolVar(&withCSV, "csv", false, "Also write the snapshot as CSV")
+	fs.BoolVar(&withMun, "municipios", false, "Also save the municipal level data")
+	setupClient := clientFlags(fs)
+	fs.Parse(args)
+	setupClient()
+
+	// Always archive fresh responses so that the fetch time in the
+	// metadata is the time the data was served.
+	sinave.DefaultClient.Cache = nil
+
+	date := time.Now()
+	if day != "" {
+		var err error
+		date, err = time.Parse(archive.DateLayout, day)
+		if err != nil {
+			return err
+		}
+	}
+
+	src, err := source.Parse(uri)
+	if err != nil {
+		return err
+	}
+	fetchedAt := time.Now().UTC()
+	sdata, err := src.Fetch(context.Background(), time.Time{})
+	if err != nil {
+		return err
+	}
+	meta := archive.Metadata{
+		Source:    src.String(),
+		FetchedAt: fetchedAt,
+	}
+	opts := archive.SnapshotOptions{
+		CSV:   withCSV,
+		Force: force,
+	}
+	err = archive.WriteSnapshot(dir, date, sdata, meta, opts)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "Saved snapshot for %s into %s\n", date.Format(archive.DateLayout), dir)
+
+	if withMun {
+		meta := archive.Metadata{
+			Source:    sinave.MunicipalURL,
+			FetchedAt: time.Now().UTC(),
+		}
+		muns, err := sinave.FetchMunicipiosContext(context.Background(), sinave.MunicipalURL)
+		if err != nil {
+			return err
+		}
+		err = archive.WriteMunicipalSnapshot(dir, date, muns, meta, opts)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(os.Stderr, "Saved municipal snapshot for %s into %s\n", date.Format(archive.DateLayout), dir)
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package source
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/wallyqs/covid19mx/sinave"
+)
+
+// DefaultFallback is the order in which the sources are tried by
+// default: the live SINAVE data, the endpoint referenced by the SINAVE
+// map page, the repo mirror, and the local archive.
+const DefaultFallback = "sinave://,sinave://mapa,mirror://,archive://data"
+
+// Fallback tries each one of its sources in order until one of them
+// has the data.
+type Fallback struct {
+	Sources []Source
+
+	// Served is the source that served the data in the last Fetch.
+	Served Source
+
+	// Errors are the errors from the sources that failed in the last
+	// Fetch, in the same order as they were tried.
+	Errors []error
+}
+
+// ParseFallback returns the fallback for a comma separated list of
+// source URIs.
+func ParseFallback(uris string) (*Fallback, error) {
+	f := &Fallback{}
+	for _, uri := range strings.Split(uris, ",") {
+		src, err := Parse(strings.TrimSpace(uri))
+		if err != nil {
+			return nil, err
+		}
+		if m, ok := src.(*Mirror); ok && m.Lookback == 0 {
+			// The mirror is usually updated once a day.
+			m.Lookback = 7
+		}
+		f.Sources = append(f.Sources, src)
+	}
+	return f, nil
+}
+
+// Fetch gets the data from the first source that has it.
+func (f *Fallback) Fetch(ctx context.Context, date time.Time) (*sinave.SinaveData, error) {
+	f.Served = nil
+	f.Errors = nil
+	for _, src := range f.Sources {
+		sdata, err := src.Fetch(ctx, date)
+		if err != nil {
+			f.Errors = append(f.Errors, fmt.Errorf("%s: %s", src, err))
+			if ctx.Err() != nil {
+				break
+			}
+			continue
+		}
+		f.Served = src
+		return sdata, nil
+	}
+	msgs := make([]string, 0, len(f.Errors))
+	for _, err := range f.Errors {
+		msgs = append(msgs, err.Error())
+	}
+	return nil, fmt.Errorf("All sources failed:\n  %s", strings.Join(msgs, "\n  "))
+}
+
+// Date returns the date of the data from the source that served it, or
+// the zero date in case it is not known.
+func (f *Fallback) Date() time.Time {
+	if d, ok := f.Served.(Dater); ok {
+		return d.Date()
+	}
+	return time.Time{}
+}
+
+// Stale reports whether the data served is from a day before today.
+func (f *Fallback) Stale() bool {
+	date := f.Date()
+	return !date.IsZero() && !isToday(date) && date.Before(time.Now())
+}
+
+func (f *Fallback) String() string {
+	names := make([]string, 0, len(f.Sources))
+	for _, src := range f.Sources {
+		names = append(names, src.String())
+	}
+	return strings.Join(names, ",")
+}
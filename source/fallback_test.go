@@ -0,0 +1,140 @@
+package source
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/wallyqs/covid19mx/sinave"
+)
+
+// fakeSource serves a single state or fails, logging its attempts.
+type fakeSource struct {
+	name  string
+	date  time.Time
+	err   error
+	tried *[]string
+}
+
+func (f *fakeSource) Fetch(ctx context.Context, date time.Time) (*sinave.SinaveData, error) {
+	*f.tried = append(*f.tried, f.name)
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &sinave.SinaveData{States: []sinave.State{{Name: f.name}}}, nil
+}
+
+func (f *fakeSource) Date() time.Time {
+	return f.date
+}
+
+func (f *fakeSource) String() string {
+	return f.name
+}
+
+// undatedSource serves data without telling its date.
+type undatedSource struct {
+	src *fakeSource
+}
+
+func (u *undatedSource) Fetch(ctx context.Context, date time.Time) (*sinave.SinaveData, error) {
+	return u.src.Fetch(ctx, date)
+}
+
+func (u *undatedSource) String() string {
+	return u.src.String()
+}
+
+func TestFallbackTriesSourcesInOrder(t *testing.T) {
+	var tried []string
+	failed := errors.New("failed")
+	f := &Fallback{Sources: []Source{
+		&fakeSource{name: "live", err: failed, tried: &tried},
+		&fakeSource{name: "mirror", date: time.Now(), tried: &tried},
+		&fakeSource{name: "archive", date: time.Now(), tried: &tried},
+	}}
+
+	sdata, err := f.Fetch(context.Background(), time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.Join(tried, ","); got != "live,mirror" {
+		t.Errorf("tried %s, want live,mirror", got)
+	}
+	if f.Served == nil || f.Served.String() != "mirror" {
+		t.Errorf("got served by %v, want mirror", f.Served)
+	}
+	if sdata.States[0].Name != "mirror" {
+		t.Errorf("got the data of %s, want the one of mirror", sdata.States[0].Name)
+	}
+	if len(f.Errors) != 1 || f.Errors[0].Error() != "live: failed" {
+		t.Errorf("got errors %v, want the one of live", f.Errors)
+	}
+	if f.Stale() {
+		t.Error("got stale data, want data from today")
+	}
+}
+
+func TestFallbackStale(t *testing.T) {
+	var tried []string
+	yesterday := time.Now().AddDate(0, 0, -1)
+	for _, tt := range []struct {
+		name  string
+		src   Source
+		stale bool
+	}{
+		{"today", &fakeSource{name: "live", date: time.Now(), tried: &tried}, false},
+		{"yesterday", &fakeSource{name: "archive", date: yesterday, tried: &tried}, true},
+		{"unknown date", &undatedSource{&fakeSource{name: "file", tried: &tried}}, false},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			f := &Fallback{Sources: []Source{tt.src}}
+			if _, err := f.Fetch(context.Background(), time.Time{}); err != nil {
+				t.Fatal(err)
+			}
+			if got := f.Stale(); got != tt.stale {
+				t.Errorf("got stale %t, want %t", got, tt.stale)
+			}
+		})
+	}
+}
+
+func TestFallbackAllFailed(t *testing.T) {
+	var tried []string
+	f := &Fallback{Sources: []Source{
+		&fakeSource{name: "live", err: errors.New("timeout"), tried: &tried},
+		&fakeSource{name: "archive", err: errors.New("no snapshots"), tried: &tried},
+	}}
+
+	_, err := f.Fetch(context.Background(), time.Time{})
+	if err == nil {
+		t.Fatal("got no error, want every source to fail")
+	}
+	for _, want := range []string{"live: timeout", "archive: no snapshots"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("got error %q, want it to contain %q", err, want)
+		}
+	}
+	if f.Served != nil {
+		t.Errorf("got served by %s, want none", f.Served)
+	}
+}
+
+func TestFallbackStopsWhenCancelled(t *testing.T) {
+	var tried []string
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	f := &Fallback{Sources: []Source{
+		&fakeSource{name: "live", err: context.Canceled, tried: &tried},
+		&fakeSource{name: "archive", tried: &tried},
+	}}
+
+	if _, err := f.Fetch(ctx, time.Time{}); err == nil {
+		t.Fatal("got no error, want the fetch to be cancelled")
+	}
+	if got := strings.Join(tried, ","); got != "live" {
+		t.Errorf("tried %s, want only live", got)
+	}
+}
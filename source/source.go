@@ -0,0 +1,273 @@
+// Package source abstracts where the state level data comes from, so
+// that the live SINAVE endpoints, the GitHub Pages mirror, a local file
+// or a local archive can be used interchangeably.
+package source
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/wallyqs/covid19mx/archive"
+	"github.com/wallyqs/covid19mx/sinave"
+)
+
+var (
+	ErrDateNotAvailable = errors.New("Source does not have data for past dates!")
+)
+
+// Source fetches the state level data published on a given date, the
+// zero date means the latest data available.
+type Source interface {
+	Fetch(ctx context.Context, date time.Time) (*sinave.SinaveData, error)
+
+	// String returns the URI of the source, e.g. archive://data
+	String() string
+}
+
+// Dater is implemented by the sources that can tell the date of the
+// data returned by the last call to Fetch.
+type Dater interface {
+	Date() time.Time
+}
+
+// Sinave gets the latest data from the SINAVE endpoints.
+type Sinave struct {
+	// Endpoint is one of the SINAVE endpoints, e.g. sinave.AttackRateURL.
+	Endpoint string
+
+	// Detect looks for the endpoint with the latest data in the SINAVE
+	// map page instead of using Endpoint.
+	Detect bool
+
+	date time.Time
+}
+
+// Fetch gets the latest data, past dates are not available.
+func (s *Sinave) Fetch(ctx context.Context, date time.Time) (*sinave.SinaveData, error) {
+	if !date.IsZero() && !isToday(date) {
+		return nil, fmt.Errorf("%s: %w", s, ErrDateNotAvailable)
+	}
+	endpoint := s.Endpoint
+	if s.Detect {
+		var err error
+		endpoint, err = sinave.DetectLatestDataSourceContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+	sdata, err := sinave.FetchDataContext(ctx, endpoint)
+	if err != nil {
+		return nil, err
+	}
+	s.date = time.Now()
+	return sdata, nil
+}
+
+// Date returns when the latest data was fetched.
+func (s *Sinave) Date() time.Time {
+	return s.date
+}
+
+func (s *Sinave) String() string {
+	if s.Detect {
+		return "sinave://mapa"
+	}
+	return s.Endpoint
+}
+
+// Mirror gets the snapshots exported to a website, e.g. sinave.RepoURL.
+type Mirror struct {
+	BaseURL string
+
+	// Lookback is the number of previous days that are tried when the
+	// latest data is requested and there is no snapshot for today.
+	Lookback int
+
+	date time.Time
+}
+
+// Fetch gets the snapshot of the given date, today by default.
+func (m *Mirror) Fetch(ctx context.Context, date time.Time) (*sinave.SinaveData, error) {
+	lookback := 0
+	if date.IsZero() {
+		date = time.Now()
+		lookback = m.Lookback
+	}
+	base := m.BaseURL
+	if !strings.HasSuffix(base, "/") {
+		base += "/"
+	}
+	for i := 0; ; i++ {
+		day := date.AddDate(0, 0, -i)
+		sdata, err := sinave.FetchPastDataContext(ctx, base+day.Format(archive.DateLayout)+".json")
+		if err == nil {
+			m.date = day
+			return sdata, nil
+		}
+		if !errors.Is(err, sinave.ErrDataNotFound) || i >= lookback {
+			return nil, err
+		}
+	}
+}
+
+// Date returns the date of the latest snapshot fetched.
+func (m *Mirror) Date() time.Time {
+	return m.date
+}
+
+func (m *Mirror) String() string {
+	return "mirror://" + strings.TrimPrefix(m.BaseURL, "https://")
+}
+
+// File gets the data from a single snapshot, either JSON or CSV.
+type File struct {
+	Path string
+}
+
+// Fetch reads the file, the date is ignored unless the file is named
+// after a different date.
+func (f *File) Fetch(ctx context.Context, date time.Time) (*sinave.SinaveData, error) {
+	if fdate, ok := archive.FileDate(f.Path); ok && !date.IsZero() && !sameDay(fdate, date) {
+		return nil, fmt.Errorf("%s: %w", f, ErrDateNotAvailable)
+	}
+	return archive.ReadFile(f.Path)
+}
+
+// Date returns the date of the snapshot based on the name of the file,
+// or the zero date in case it is not named after a date.
+func (f *File) Date() time.Time {
+	date, _ := archive.FileDate(f.Path)
+	return date
+}
+
+func (f *File) String() string {
+	return "file://" + f.Path
+}
+
+// Archive gets the snapshots from a local archive directory.
+type Archive struct {
+	Dir string
+
+	store *archive.Store
+	date  time.Time
+}
+
+// Fetch gets the snapshot of the given date, the latest one by default.
+func (a *Archive) Fetch(ctx context.Context, date time.Time) (*sinave.SinaveData, error) {
+	store, err := a.Store()
+	if err != nil {
+		return nil, err
+	}
+	if date.IsZero() {
+		sdata, latest, err := store.Latest()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", a.Dir, err)
+		}
+		a.date = latest
+		return sdata, nil
+	}
+	sdata, err := store.Snapshot(date)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", a.Dir, err)
+	}
+	a.date = date
+	return sdata, nil
+}
+
+// Date returns the date of the latest snapshot fetched.
+func (a *Archive) Date() time.Time {
+	return a.date
+}
+
+// Store returns the archive with the snapshots.
+func (a *Archive) Store() (*archive.Store, error) {
+	if a.store == nil {
+		store, err := archive.Open(a.Dir)
+		if err != nil {
+			return nil, err
+		}
+		a.store = store
+	}
+	return a.store, nil
+}
+
+func (a *Archive) String() string {
+	return "archive://" + a.Dir
+}
+
+// Parse returns the source for a URI:
+//
+//	sinave://               Latest data from sinave.AttackRateURL
+//	sinave://mapa           Latest data from the endpoint in sinave.MapURL
+//	mirror://               Snapshots from sinave.RepoURL
+//	mirror://example.com/d  Snapshots from https://example.com/d/
+//	file://data/a.json      A single snapshot, also plain paths with
+//	                        a .json or .csv extension
+//	archive://data          Snapshots from a local archive directory
+//	https://...             Any SINAVE endpoint, or a single snapshot
+//	                        when it has a .json extension
+//
+// An empty URI is the same as sinave://
+func Parse(uri string) (Source, error) {
+	switch {
+	case uri == "":
+		return &Sinave{Endpoint: sinave.AttackRateURL}, nil
+	case strings.HasPrefix(uri, "sinave://"):
+		switch strings.TrimPrefix(uri, "sinave://") {
+		case "", "tasas":
+			return &Sinave{Endpoint: sinave.AttackRateURL}, nil
+		case "mapa":
+			return &Sinave{Detect: true}, nil
+		}
+		return nil, fmt.Errorf("Unknown source %q", uri)
+	case strings.HasPrefix(uri, "mirror://"):
+		host := strings.TrimPrefix(uri, "mirror://")
+		if host == "" {
+			return &Mirror{BaseURL: sinave.RepoURL}, nil
+		}
+		return &Mirror{BaseURL: "https://" + host}, nil
+	case strings.HasPrefix(uri, "file://"):
+		return &File{Path: strings.TrimPrefix(uri, "file://")}, nil
+	case strings.HasPrefix(uri, "archive://"):
+		return &Archive{Dir: strings.TrimPrefix(uri, "archive://")}, nil
+	case strings.HasPrefix(uri, "http://") || strings.HasPrefix(uri, "https://"):
+		u, err := url.Parse(uri)
+		if err != nil {
+			return nil, err
+		}
+		if strings.HasSuffix(u.Path, ".json") {
+			return &remoteFile{URL: uri}, nil
+		}
+		return &Sinave{Endpoint: uri}, nil
+	case strings.HasSuffix(uri, ".json") || strings.HasSuffix(uri, ".csv"):
+		return &File{Path: uri}, nil
+	}
+	return nil, fmt.Errorf("Unknown source %q", uri)
+}
+
+// remoteFile gets a single snapshot from a url.
+type remoteFile struct {
+	URL string
+}
+
+func (r *remoteFile) Fetch(ctx context.Context, date time.Time) (*sinave.SinaveData, error) {
+	return sinave.FetchPastDataContext(ctx, r.URL)
+}
+
+func (r *remoteFile) String() string {
+	return r.URL
+}
+
+func isToday(date time.Time) bool {
+	return sameDay(date, time.Now())
+}
+
+func sameDay(a, b time.Time) bool {
+	ay, am, ad := a.Date()
+	by, bm, bd := b.Date()
+	return ay == by && am == bm && ad == bd
+}
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/wallyqs/covid19mx/sinave"
+)
+
+// runValidate checks raw SINAVE payloads against the expected schema.
+func runValidate(args []string) error {
+	fs := flag.NewFlagSet("validate", flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Printf("Usage: covid19mx validate [options...] [file|url...]\n\n")
+		fmt.Printf("Checks the payloads from the files or urls, by default %s\n\n", sinave.AttackRateURL)
+		fs.PrintDefaults()
+		fmt.Println()
+	}
+	setupClient := clientFlags(fs)
+	fs.Parse(args)
+	setupClient()
+
+	inputs := fs.Args()
+	if len(inputs) == 0 {
+		inputs = []string{sinave.AttackRateURL}
+	}
+	failed := false
+	for _, input := range inputs {
+		b, err := readPayload(input)
+		if err != nil {
+			return err
+		}
+		rows, errs := sinave.Validate(b)
+		if len(errs) == 0 {
+			fmt.Printf("%s: OK, %d rows\n", input, rows)
+			continue
+		}
+		failed = true
+		fmt.Printf("%s: %d errors in %d rows\n", input, len(errs), rows)
+		for _, err := range errs {
+			fmt.Printf("  %s\n", err)
+		}
+	}
+	if failed {
+		return errors.New("Payload does not match the schema")
+	}
+	return nil
+}
+
+// readPayload reads a raw payload from a file or from one of the SINAVE
+// endpoints.
+func readPayload(input string) ([]byte, error) {
+	if !strings.HasPrefix(input, "http://") && !strings.HasPrefix(input, "https://") {
+		return ioutil.ReadFile(input)
+	}
+	resp, err := sinave.DefaultClient.Do(context.Background(), "POST", input, "application/json; charset=UTF-8", nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("Error: %s", resp.Body)
+	}
+	return resp.Body, nil
+}